@@ -154,6 +154,7 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx,
 	blockHeight := big.NewInt(ctx.BlockHeight())
 	homestead := ethCfg.IsHomestead(blockHeight)
 	istanbul := ethCfg.IsIstanbul(blockHeight)
+	shanghai := evmParams.IsEIPActivated(3860, blockHeight)
 	var events cosmos.Events
 
 	// Use the lowest priority of all the messages as the final one.
@@ -183,7 +184,11 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx,
 			gasWanted += txData.GetGas()
 		}
 
-		fees, err := keeper.VerifyFee(txData, evmDenom, baseFee, homestead, istanbul, ctx.IsCheckTx())
+		if err := txs.ValidateDynamicFee(txData, baseFee); err != nil {
+			return ctx, errorsmod.Wrap(err, "failed to verify the dynamic fee")
+		}
+
+		fees, err := keeper.VerifyFee(txData, evmDenom, baseFee, homestead, istanbul, shanghai, ctx.IsCheckTx())
 		if err != nil {
 			return ctx, errorsmod.Wrapf(err, "failed to verify the fees")
 		}
@@ -217,7 +222,7 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx,
 
 	ctx.EventManager().EmitEvents(events)
 
-	blockGasLimit := artela.BlockGasLimit(ctx)
+	blockGasLimit := evmParams.EffectiveBlockGasLimit(artela.BlockGasLimit(ctx))
 
 	// return error if the tx gas is greater than the block limit (max gas)
 
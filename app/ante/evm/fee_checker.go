@@ -71,8 +71,7 @@ func NewDynamicFeeChecker(k interfaces.DynamicFeeEVMKeeper) anteutils.TxFeeCheck
 			return nil, 0, errorsmod.Wrapf(errortypes.ErrInsufficientFee, "gas prices too low, got: %s%s required: %s%s. Please retry using a higher gas price or a higher fee", feeCap, denom, baseFeeInt, denom)
 		}
 
-		// calculate the effective gas price using the EIP-1559 logic.
-		effectivePrice := sdkmath.NewIntFromBigInt(txs.EffectiveGasPrice(baseFeeInt.BigInt(), feeCap.BigInt(), maxPriorityPrice.BigInt()))
+		effectivePrice, priority := DynamicFeePriority(baseFeeInt, feeCap, maxPriorityPrice)
 
 		// NOTE: create a new coins slice without having to validate the denom
 		effectiveFee := cosmos.Coins{
@@ -82,15 +81,25 @@ func NewDynamicFeeChecker(k interfaces.DynamicFeeEVMKeeper) anteutils.TxFeeCheck
 			},
 		}
 
-		bigPriority := effectivePrice.Sub(baseFeeInt).Quo(txs.DefaultPriorityReduction)
-		priority := int64(math.MaxInt64)
+		return effectiveFee, priority, nil
+	}
+}
 
-		if bigPriority.IsInt64() {
-			priority = bigPriority.Int64()
-		}
+// DynamicFeePriority returns the effective gas price of a Cosmos txs paying up to feeCap per
+// unit of gas, and the priority assigned to it, i.e. the effective tip divided by the
+// DefaultPriorityReduction, capped at MaxInt64.
+func DynamicFeePriority(baseFee, feeCap, maxPriorityPrice sdkmath.Int) (sdkmath.Int, int64) {
+	// calculate the effective gas price using the EIP-1559 logic.
+	effectivePrice := sdkmath.NewIntFromBigInt(txs.EffectiveGasPrice(baseFee.BigInt(), feeCap.BigInt(), maxPriorityPrice.BigInt()))
 
-		return effectiveFee, priority, nil
+	bigPriority := effectivePrice.Sub(baseFee).Quo(txs.DefaultPriorityReduction)
+	priority := int64(math.MaxInt64)
+
+	if bigPriority.IsInt64() {
+		priority = bigPriority.Int64()
 	}
+
+	return effectivePrice, priority
 }
 
 // checkTxFeeWithValidatorMinGasPrices implements the default fee logic, where the minimum price per
@@ -45,7 +45,7 @@ func (gwd GasWantedDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx, simul
 
 	gasWanted := feeTx.GetGas()
 	// return error if the tx gas is greater than the block limit (max gas)
-	blockGasLimit := types.BlockGasLimit(ctx)
+	blockGasLimit := evmParams.EffectiveBlockGasLimit(types.BlockGasLimit(ctx))
 	if gasWanted > blockGasLimit {
 		return ctx, errorsmod.Wrapf(
 			errortypes.ErrOutOfGas,
@@ -556,6 +556,7 @@ func NewArtela(
 		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.FeeKeeper,
 		"1", app.GetSubspace(evmmoduletypes.ModuleName), bApp, logger,
 	)
+	app.EvmKeeper.SetMempool(bApp.Mempool())
 	evmModule := evmmodule.NewAppModule(app.EvmKeeper, app.AccountKeeper, app.GetSubspace(evmmoduletypes.ModuleName))
 
 	// this line is used by starport scaffolding # stargate/app/keeperDefinition
@@ -218,33 +218,17 @@ func (b *BackendImpl) getAccountNonce(accAddr common.Address, pending bool, heig
 		return nonce, nil
 	}
 
-	// the account retriever doesn't include the uncommitted transactions on the nonce so we need to
-	// to manually add them.
-	pendingTxs, err := b.PendingTransactions()
+	// the account retriever doesn't include the uncommitted transactions on the nonce, the evm
+	// module counts the transactions of the account queued in the mempool.
+	pendingRes, err := b.queryClient.PendingNonce(b.ctx, &txs.QueryPendingNonceRequest{Address: accAddr.Hex()})
 	if err != nil {
+		b.logger.Debug("getAccountNonce failed to query the pending nonce", "error", err)
 		return nonce, nil
 	}
 
-	// add the uncommitted txs to the nonce counter
-	// only supports `MsgEthereumTx` style tx
-	for _, tx := range pendingTxs {
-		for _, msg := range (*tx).GetMsgs() {
-			ethMsg, ok := msg.(*txs.MsgEthereumTx)
-			if !ok {
-				// not ethereum tx
-				break
-			}
-
-			sender, err := b.GetSender(ethMsg, b.chainID)
-			if err != nil {
-				continue
-			}
-			if sender == accAddr {
-				nonce++
-			}
-		}
+	if pendingRes.Nonce > nonce {
+		nonce = pendingRes.Nonce
 	}
-
 	return nonce, nil
 }
 
@@ -40,6 +40,7 @@ import (
 	"github.com/artela-network/artela/ethereum/types"
 	ethereumtypes "github.com/artela-network/artela/ethereum/types"
 	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
 	evmtypes "github.com/artela-network/artela/x/evm/types"
 	feetypes "github.com/artela-network/artela/x/fee/types"
 )
@@ -180,6 +181,10 @@ func (b *BackendImpl) FeeHistory(blockCount uint64, lastBlock rpc.BlockNumber,
 		blockEnd = int64(blockNumber)
 	}
 
+	if err := support.ValidateRewardPercentiles(rewardPercentiles); err != nil {
+		return nil, err
+	}
+
 	blocks := int64(blockCount)
 	maxBlockCount := int64(b.cfg.AppCfg.JSONRPC.FeeHistoryCap)
 	if blocks > maxBlockCount {
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"math"
 	"math/big"
-	"sort"
 	"strconv"
 
 	"github.com/artela-network/artela-evm/vm"
@@ -33,6 +32,7 @@ import (
 	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
 	"github.com/artela-network/artela/ethereum/rpc/utils"
 	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
 	evmtypes "github.com/artela-network/artela/x/evm/types"
 )
 
@@ -427,7 +427,7 @@ func (b *BackendImpl) EthMsgsFromCosmosBlock(resBlock *tmrpctypes.ResultBlock, b
 	return result
 }
 
-func (b *BackendImpl) DoCall(args ethapi.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash) (*txs.MsgEthereumTxResponse, error) {
+func (b *BackendImpl) DoCall(args ethapi.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *ethapi.StateOverride, blockOverrides *ethapi.BlockOverrides) (*txs.MsgEthereumTxResponse, error) {
 	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
 	if err != nil {
 		return nil, err
@@ -449,6 +449,16 @@ func (b *BackendImpl) DoCall(args ethapi.TransactionArgs, blockNrOrHash rpc.Bloc
 		ProposerAddress: sdktypes.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
 	}
+	if overrides != nil {
+		if req.Overrides, err = json.Marshal(overrides); err != nil {
+			return nil, err
+		}
+	}
+	if blockOverrides != nil {
+		if req.BlockOverrides, err = json.Marshal(blockOverrides); err != nil {
+			return nil, err
+		}
+	}
 
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
@@ -475,7 +485,7 @@ func (b *BackendImpl) DoCall(args ethapi.TransactionArgs, blockNrOrHash rpc.Bloc
 	}
 
 	if res.Failed() {
-		if res.VmError != vm.ErrExecutionReverted.Error() {
+		if !errors.Is(res.Err(), evmtypes.ErrExecutionReverted) {
 			return nil, status.Error(codes.Internal, res.VmError)
 		}
 		return nil, evmtypes.NewExecErrorWithReason(res.Ret)
@@ -538,20 +548,13 @@ func (b *BackendImpl) processBlock(
 	}
 
 	gasUsedRatio := gasusedfloat / float64(gasLimitUint64)
-	blockGasUsed := gasusedfloat
 	targetOneFeeHistory.GasUsedRatio = gasUsedRatio
 
-	rewardCount := len(rewardPercentiles)
-	targetOneFeeHistory.Reward = make([]*big.Int, rewardCount)
-	for i := 0; i < rewardCount; i++ {
-		targetOneFeeHistory.Reward[i] = big.NewInt(0)
-	}
-
 	tendermintTxs := tendermintBlock.Block.Txs
 	tendermintTxResults := tendermintBlockResult.TxsResults
 	tendermintTxCount := len(tendermintTxs)
 
-	var sorter sortGasAndReward
+	var feeTxs []support.FeeHistoryTx
 
 	for i := 0; i < tendermintTxCount; i++ {
 		eachTendermintTx := tendermintTxs[i]
@@ -573,45 +576,12 @@ func (b *BackendImpl) processBlock(
 			if reward == nil {
 				reward = big.NewInt(0)
 			}
-			sorter = append(sorter, txGasAndReward{gasUsed: txGasUsed, reward: reward})
+			feeTxs = append(feeTxs, support.FeeHistoryTx{GasUsed: txGasUsed, Reward: reward})
 		}
 	}
 
-	// return an all zero row if there are no transactions to gather data from
-	ethTxCount := len(sorter)
-	if ethTxCount == 0 {
-		return targetOneFeeHistory, nil
-	}
-
-	sort.Sort(sorter)
-
-	var txIndex int
-	sumGasUsed := sorter[0].gasUsed
-
-	for i, p := range rewardPercentiles {
-		thresholdGasUsed := uint64(blockGasUsed * p / 100) // #nosec G701
-		for sumGasUsed < thresholdGasUsed && txIndex < ethTxCount-1 {
-			txIndex++
-			sumGasUsed += sorter[txIndex].gasUsed
-		}
-		targetOneFeeHistory.Reward[i] = sorter[txIndex].reward
-	}
+	// an all zero row is returned if there are no transactions to gather data from
+	targetOneFeeHistory.Reward = support.FeeHistoryRewards(feeTxs, uint64(gasUsed), rewardPercentiles)
 
 	return targetOneFeeHistory, nil
 }
-
-type txGasAndReward struct {
-	gasUsed uint64
-	reward  *big.Int
-}
-
-type sortGasAndReward []txGasAndReward
-
-func (s sortGasAndReward) Len() int { return len(s) }
-func (s sortGasAndReward) Swap(i, j int) {
-	s[i], s[j] = s[j], s[i]
-}
-
-func (s sortGasAndReward) Less(i, j int) bool {
-	return s[i].reward.Cmp(s[j].reward) < 0
-}
@@ -59,7 +59,7 @@ type Backend interface {
 	RPCTxFeeCap() float64
 	UnprotectedAllowed() bool
 	EstimateGas(ctx context.Context, args TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (hexutil.Uint64, error)
-	DoCall(args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash) (*txs.MsgEthereumTxResponse, error)
+	DoCall(args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *StateOverride, blockOverrides *BlockOverrides) (*txs.MsgEthereumTxResponse, error)
 
 	ChainConfig() *params.ChainConfig
 	Engine() consensus.Engine
@@ -18,6 +18,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/artela-network/artela/ethereum/rpc/utils"
+	"github.com/artela-network/artela/x/evm/txs/support"
 )
 
 // BloomIV represents the bit indexes and value inside the bloom filter that belong
@@ -183,7 +184,7 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
-	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
+	if !support.BloomMatches(bloom, f.criteria.Addresses, f.criteria.Topics) {
 		return []*ethtypes.Log{}, nil
 	}
 
@@ -103,6 +103,40 @@ func GetArtelaAddressFromBech32(address string) (sdk.AccAddress, error) {
 	return sdk.AccAddress(addressBz), nil
 }
 
+// ConvertCosmosToEth converts the bech32 addresses to Ethereum hex addresses, following
+// GetArtelaAddressFromBech32. The conversion stops at the first malformed address, whose
+// index is reported in the returned error.
+func ConvertCosmosToEth(addrs []string) ([]common.Address, error) {
+	res := make([]common.Address, len(addrs))
+	for i, addr := range addrs {
+		accAddr, err := GetArtelaAddressFromBech32(addr)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "address %d", i)
+		}
+		res[i] = common.BytesToAddress(accAddr)
+	}
+	return res, nil
+}
+
+// ConvertEthToCosmos converts the Ethereum hex addresses to bech32 addresses with the given
+// human readable prefix. The conversion stops at the first address failing to convert, whose
+// index is reported in the returned error.
+func ConvertEthToCosmos(addrs []common.Address, prefix string) ([]string, error) {
+	if prefix == "" {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidAddress, "empty bech32 prefix")
+	}
+
+	res := make([]string, len(addrs))
+	for i, addr := range addrs {
+		bech32Addr, err := sdk.Bech32ifyAddressBytes(prefix, addr.Bytes())
+		if err != nil {
+			return nil, errorsmod.Wrapf(errortypes.ErrInvalidAddress, "address %d: %s", i, err.Error())
+		}
+		res[i] = bech32Addr
+	}
+	return res, nil
+}
+
 func IsCustomizedVerification(tx *ethereum.Transaction) bool {
 	v, r, s := tx.RawSignatureValues()
 	zero := big.NewInt(0)
@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestConvertAddresses(t *testing.T) {
+	ethAddrs := []common.Address{
+		common.HexToAddress("0x1"),
+		common.HexToAddress("0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E"),
+		{},
+	}
+
+	bech32Addrs, err := ConvertEthToCosmos(ethAddrs, "artela")
+	require.NoError(t, err)
+	require.Len(t, bech32Addrs, len(ethAddrs))
+	for i, addr := range bech32Addrs {
+		expected, err := sdk.Bech32ifyAddressBytes("artela", ethAddrs[i].Bytes())
+		require.NoError(t, err)
+		require.Equal(t, expected, addr)
+	}
+
+	// the conversion roundtrips, whatever the bech32 prefix
+	res, err := ConvertCosmosToEth(bech32Addrs)
+	require.NoError(t, err)
+	require.Equal(t, ethAddrs, res)
+
+	cosmosAddrs, err := ConvertEthToCosmos(ethAddrs, "cosmos")
+	require.NoError(t, err)
+	res, err = ConvertCosmosToEth(cosmosAddrs)
+	require.NoError(t, err)
+	require.Equal(t, ethAddrs, res)
+
+	_, err = ConvertEthToCosmos(ethAddrs, "")
+	require.ErrorIs(t, err, errortypes.ErrInvalidAddress)
+
+	res, err = ConvertCosmosToEth(nil)
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
+
+func TestConvertCosmosToEthInvalid(t *testing.T) {
+	valid, err := ConvertEthToCosmos([]common.Address{common.HexToAddress("0x1"), common.HexToAddress("0x2")}, "artela")
+	require.NoError(t, err)
+
+	// a bad checksum in the middle of the batch
+	bad := valid[1][:len(valid[1])-1] + "q"
+	if bad == valid[1] {
+		bad = valid[1][:len(valid[1])-1] + "p"
+	}
+
+	res, err := ConvertCosmosToEth([]string{valid[0], bad, valid[1]})
+	require.ErrorIs(t, err, errortypes.ErrInvalidAddress)
+	require.Contains(t, err.Error(), "address 1")
+	require.Nil(t, res)
+
+	_, err = ConvertCosmosToEth([]string{valid[0], "0x0000000000000000000000000000000000000001"})
+	require.ErrorIs(t, err, errortypes.ErrInvalidAddress)
+	require.Contains(t, err.Error(), "address 1")
+}
@@ -1,8 +1,11 @@
 package tx
 
 import (
+	"fmt"
 	"math"
+	"math/big"
 
+	artela "github.com/artela-network/artela/ethereum/types"
 	"github.com/artela-network/artela/ethereum/utils"
 
 	sdkmath "cosmossdk.io/math"
@@ -14,31 +17,59 @@ import (
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 
 	"github.com/artela-network/artela/app"
+	evmante "github.com/artela-network/artela/app/ante/evm"
 )
 
 var (
-	feeAmt     = math.Pow10(16)
+	feeAmt = math.Pow10(16)
+	// DefaultFee is kept for backward compatibility, PrepareCosmosTx does not read it.
+	// Use CosmosTxArgs.DefaultFeeAmount to change the fallback fee of a txs.
 	DefaultFee = sdk.NewCoin(utils.BaseDenom, sdk.NewIntFromUint64(uint64(feeAmt))) // 0.01 Artela
 )
 
+// DefaultFeeAmount is the fee amount, in base denom, used when neither a gas price
+// nor a default fee amount is provided on the CosmosTxArgs (0.01 Artela)
+const DefaultFeeAmount uint64 = 1e16
+
 // CosmosTxArgs contains the params to create a cosmos txs
 type CosmosTxArgs struct {
 	// TxCfg is the client txs config
 	TxCfg client.TxConfig
 	// Priv is the private key that will be used to sign the txs
 	Priv cryptotypes.PrivKey
+	// AdditionalPrivs are the private keys of the optional extra signers, which sign
+	// the txs in order after Priv
+	AdditionalPrivs []cryptotypes.PrivKey
 	// ChainID is the chain's id on cosmos format, e.g. 'artela_11822-1'
 	ChainID string
 	// Gas to be used on the txs
 	Gas uint64
+	// The fees of the txs are selected in the following order:
+	// Fees when non-empty, then (BaseFee + Tip) * Gas, then GasPrice * Gas,
+	// then DefaultFeeAmount.
+	//
+	// BaseFee is the current EIP-1559 base fee
+	BaseFee *sdkmath.Int
+	// Tip is the optional priority fee paid on top of the BaseFee
+	Tip *sdkmath.Int
 	// GasPrice to use on txs
 	GasPrice *sdkmath.Int
+	// DefaultFeeAmount is the fee amount in base denom used when Fees is empty and
+	// GasPrice is nil, defaults to DefaultFeeAmount
+	DefaultFeeAmount *sdkmath.Int
 	// Fees is the fee to be used on the txs (amount and denom)
 	Fees sdk.Coins
 	// FeeGranter is the account address of the fee granter
 	FeeGranter sdk.AccAddress
 	// Msgs slice of messages to include on the txs
 	Msgs []sdk.Msg
+	// Memo is the optional memo attached to the txs
+	Memo string
+	// TimeoutHeight is the optional block height after which the txs is not valid
+	TimeoutHeight uint64
+	// SignMode is the mode used to sign the txs, defaults to the sign mode handler's
+	// default mode when unspecified
+	SignMode signing.SignMode
 }
 
 // PrepareCosmosTx creates a cosmos txs and signs it with the provided messages and private key.
@@ -52,11 +83,9 @@ func PrepareCosmosTx(
 
 	txBuilder.SetGasLimit(args.Gas)
 
-	var fees sdk.Coins
-	if args.GasPrice != nil {
-		fees = sdk.Coins{{Denom: utils.BaseDenom, Amount: args.GasPrice.MulRaw(int64(args.Gas))}}
-	} else {
-		fees = sdk.Coins{DefaultFee}
+	fees, err := txFees(args)
+	if err != nil {
+		return nil, err
 	}
 
 	txBuilder.SetFeeAmount(fees)
@@ -65,6 +94,8 @@ func PrepareCosmosTx(
 	}
 
 	txBuilder.SetFeeGranter(args.FeeGranter)
+	txBuilder.SetMemo(args.Memo)
+	txBuilder.SetTimeoutHeight(args.TimeoutHeight)
 
 	return signCosmosTx(
 		ctx,
@@ -74,56 +105,143 @@ func PrepareCosmosTx(
 	)
 }
 
+// PrepareCosmosTxBytes creates and signs a cosmos txs like PrepareCosmosTx, and
+// encodes it with the txs encoder of the provided config.
+// It returns the signed txs, its bytes and an error
+func PrepareCosmosTxBytes(
+	ctx sdk.Context,
+	appArtela *app.Artela,
+	args CosmosTxArgs,
+) (authsigning.Tx, []byte, error) {
+	signedTx, err := PrepareCosmosTx(ctx, appArtela, args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txBytes, err := args.TxCfg.TxEncoder()(signedTx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return signedTx, txBytes, nil
+}
+
+// txFees returns the fees to be paid by the cosmos txs built from the provided args
+func txFees(args CosmosTxArgs) (sdk.Coins, error) {
+	if !args.Fees.Empty() {
+		return args.Fees, nil
+	}
+
+	if args.BaseFee != nil {
+		gasPrice := new(big.Int).Set(args.BaseFee.BigInt())
+		if args.Tip != nil {
+			gasPrice.Add(gasPrice, args.Tip.BigInt())
+		}
+		feeAmount, err := artela.SafeNewIntFromBigInt(gasPrice.Mul(gasPrice, new(big.Int).SetUint64(args.Gas)))
+		if err != nil {
+			return nil, fmt.Errorf("invalid fee for base fee %s and gas %d: %w", args.BaseFee, args.Gas, err)
+		}
+		return sdk.Coins{{Denom: utils.BaseDenom, Amount: feeAmount}}, nil
+	}
+
+	if args.GasPrice != nil {
+		return sdk.Coins{{Denom: utils.BaseDenom, Amount: args.GasPrice.MulRaw(int64(args.Gas))}}, nil
+	}
+
+	feeAmount := sdkmath.NewIntFromUint64(DefaultFeeAmount)
+	if args.DefaultFeeAmount != nil {
+		feeAmount = *args.DefaultFeeAmount
+	}
+	return sdk.Coins{{Denom: utils.BaseDenom, Amount: feeAmount}}, nil
+}
+
+// ComputeFeeAndPriority returns the fees of the txs built from the args and the priority the
+// dynamic fee checker assigns to it for the given base fee, e.g. to assert the ordering of
+// txs paying different tips. As no max priority price extension option is set, the whole
+// fee above the base fee is the tip. The priority is 0 if the fees cannot be computed or are
+// below the base fee.
+func (args CosmosTxArgs) ComputeFeeAndPriority(baseFee sdkmath.Int) (sdk.Coins, int64) {
+	fees, err := txFees(args)
+	if err != nil || args.Gas == 0 {
+		return fees, 0
+	}
+
+	feeCap := fees.AmountOfNoDenomValidation(utils.BaseDenom).Quo(sdkmath.NewIntFromUint64(args.Gas))
+	if feeCap.LT(baseFee) {
+		return fees, 0
+	}
+
+	_, priority := evmante.DynamicFeePriority(baseFee, feeCap, sdkmath.NewInt(math.MaxInt64))
+	return fees, priority
+}
+
 // signCosmosTx signs the cosmos txs on the txBuilder provided using
-// the provided private key
+// the provided private keys
 func signCosmosTx(
 	ctx sdk.Context,
 	appArtela *app.Artela,
 	args CosmosTxArgs,
 	txBuilder client.TxBuilder,
 ) (authsigning.Tx, error) {
-	addr := sdk.AccAddress(args.Priv.PubKey().Address().Bytes())
-	seq, err := appArtela.AccountKeeper.GetSequence(ctx, addr)
-	if err != nil {
-		return nil, err
+	signMode := args.SignMode
+	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		signMode = args.TxCfg.SignModeHandler().DefaultMode()
 	}
 
+	privs := append([]cryptotypes.PrivKey{args.Priv}, args.AdditionalPrivs...)
+	seqs := make([]uint64, len(privs))
+	sigsV2 := make([]signing.SignatureV2, len(privs))
+
 	// First round: we gather all the signer infos. We use the "set empty
 	// signature" hack to do that.
-	sigV2 := signing.SignatureV2{
-		PubKey: args.Priv.PubKey(),
-		Data: &signing.SingleSignatureData{
-			SignMode:  args.TxCfg.SignModeHandler().DefaultMode(),
-			Signature: nil,
-		},
-		Sequence: seq,
-	}
+	for i, priv := range privs {
+		addr := sdk.AccAddress(priv.PubKey().Address().Bytes())
+		seq, err := appArtela.AccountKeeper.GetSequence(ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+		seqs[i] = seq
 
-	sigsV2 := []signing.SignatureV2{sigV2}
+		sigsV2[i] = signing.SignatureV2{
+			PubKey: priv.PubKey(),
+			Data: &signing.SingleSignatureData{
+				SignMode:  signMode,
+				Signature: nil,
+			},
+			Sequence: seq,
+		}
+	}
 
 	if err := txBuilder.SetSignatures(sigsV2...); err != nil {
 		return nil, err
 	}
 
 	// Second round: all signer infos are set, so each signer can sign.
-	accNumber := appArtela.AccountKeeper.GetAccount(ctx, addr).GetAccountNumber()
-	signerData := authsigning.SignerData{
-		ChainID:       args.ChainID,
-		AccountNumber: accNumber,
-		Sequence:      seq,
-	}
-	sigV2, err = tx.SignWithPrivKey(
-		args.TxCfg.SignModeHandler().DefaultMode(),
-		signerData,
-		txBuilder, args.Priv, args.TxCfg,
-		seq,
-	)
-	if err != nil {
-		return nil, err
+	sigsV2 = make([]signing.SignatureV2, len(privs))
+	for i, priv := range privs {
+		addr := sdk.AccAddress(priv.PubKey().Address().Bytes())
+		acc := appArtela.AccountKeeper.GetAccount(ctx, addr)
+		if acc == nil {
+			return nil, fmt.Errorf("account %s not found", addr)
+		}
+
+		signerData := authsigning.SignerData{
+			ChainID:       args.ChainID,
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      seqs[i],
+		}
+		sigV2, err := tx.SignWithPrivKey(
+			signMode,
+			signerData,
+			txBuilder, priv, args.TxCfg,
+			seqs[i],
+		)
+		if err != nil {
+			return nil, err
+		}
+		sigsV2[i] = sigV2
 	}
 
-	sigsV2 = []signing.SignatureV2{sigV2}
-	if err = txBuilder.SetSignatures(sigsV2...); err != nil {
+	if err := txBuilder.SetSignatures(sigsV2...); err != nil {
 		return nil, err
 	}
 	return txBuilder.GetTx(), nil
@@ -132,11 +250,36 @@ func signCosmosTx(
 var _ sdk.Tx = &InvalidTx{}
 
 // InvalidTx defines a type, which satisfies the sdk.Tx interface, but
-// holds no valid txs information.
+// holds no valid txs information. The zero value holds a single nil message
+// and passes ValidateBasic, use the constructors below for other failure shapes.
 //
 // NOTE: This is used for testing purposes, to serve the edge case of invalid data being passed to functions.
-type InvalidTx struct{}
+type InvalidTx struct {
+	msgs []sdk.Msg
+	err  error
+}
+
+// NewInvalidTxNoMsgs returns an InvalidTx which holds no messages
+func NewInvalidTxNoMsgs() InvalidTx {
+	return InvalidTx{msgs: []sdk.Msg{}}
+}
 
-func (InvalidTx) GetMsgs() []sdk.Msg { return []sdk.Msg{nil} }
+// NewInvalidTxFailingValidate returns an InvalidTx whose ValidateBasic returns the given error
+func NewInvalidTxFailingValidate(err error) InvalidTx {
+	return InvalidTx{err: err}
+}
+
+// NewInvalidTxWrongMsg returns an InvalidTx which holds the given message, e.g. a message
+// of a type not expected by the decorator under test
+func NewInvalidTxWrongMsg(msg sdk.Msg) InvalidTx {
+	return InvalidTx{msgs: []sdk.Msg{msg}}
+}
+
+func (tx InvalidTx) GetMsgs() []sdk.Msg {
+	if tx.msgs == nil {
+		return []sdk.Msg{nil}
+	}
+	return tx.msgs
+}
 
-func (InvalidTx) ValidateBasic() error { return nil }
+func (tx InvalidTx) ValidateBasic() error { return tx.err }
@@ -0,0 +1,98 @@
+package tx
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/ethereum/utils"
+)
+
+func TestTxFeesDefaultFeeAmount(t *testing.T) {
+	fees, err := txFees(CosmosTxArgs{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.Coins{DefaultFee}, fees)
+
+	for i := int64(1); i <= 8; i++ {
+		amount := sdkmath.NewInt(i * 1000)
+		t.Run(fmt.Sprintf("fee %s", amount), func(t *testing.T) {
+			t.Parallel()
+			fees, err := txFees(CosmosTxArgs{DefaultFeeAmount: &amount})
+			require.NoError(t, err)
+			require.Equal(t, sdk.Coins{{Denom: utils.BaseDenom, Amount: amount}}, fees)
+		})
+	}
+}
+
+func TestTxFeesExplicitFees(t *testing.T) {
+	gasPrice := sdkmath.NewInt(10)
+	fees := sdk.NewCoins(
+		sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(100)),
+		sdk.NewCoin("uatom", sdkmath.NewInt(5)),
+	)
+
+	res, err := txFees(CosmosTxArgs{Fees: fees, GasPrice: &gasPrice, Gas: 21000})
+	require.NoError(t, err)
+	require.Equal(t, fees, res)
+
+	res, err = txFees(CosmosTxArgs{GasPrice: &gasPrice, Gas: 21000})
+	require.NoError(t, err)
+	require.Equal(t, sdk.Coins{{Denom: utils.BaseDenom, Amount: sdkmath.NewInt(210000)}}, res)
+}
+
+func TestTxFeesBaseFee(t *testing.T) {
+	baseFee := sdkmath.NewInt(1_000_000_000)
+	tip := sdkmath.NewInt(2)
+
+	// (1_000_000_000 + 2) * 21000
+	res, err := txFees(CosmosTxArgs{BaseFee: &baseFee, Tip: &tip, Gas: 21000})
+	require.NoError(t, err)
+	require.Equal(t, sdk.Coins{{Denom: utils.BaseDenom, Amount: sdkmath.NewInt(21_000_000_042_000)}}, res)
+
+	res, err = txFees(CosmosTxArgs{BaseFee: &baseFee, Gas: 21000})
+	require.NoError(t, err)
+	require.Equal(t, sdk.Coins{{Denom: utils.BaseDenom, Amount: sdkmath.NewInt(21_000_000_000_000)}}, res)
+
+	// the fee of a 255 bits base fee overflows 256 bits for a large gas limit
+	hugeBaseFee := sdkmath.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1)))
+	_, err = txFees(CosmosTxArgs{BaseFee: &hugeBaseFee, Gas: math.MaxUint64})
+	require.Error(t, err)
+}
+
+func TestInvalidTx(t *testing.T) {
+	require.Equal(t, []sdk.Msg{nil}, InvalidTx{}.GetMsgs())
+	require.NoError(t, InvalidTx{}.ValidateBasic())
+
+	require.Empty(t, NewInvalidTxNoMsgs().GetMsgs())
+
+	validateErr := fmt.Errorf("invalid tx")
+	require.ErrorIs(t, NewInvalidTxFailingValidate(validateErr).ValidateBasic(), validateErr)
+
+	msg := &banktypes.MsgSend{}
+	require.Equal(t, []sdk.Msg{msg}, NewInvalidTxWrongMsg(msg).GetMsgs())
+}
+
+func TestComputeFeeAndPriority(t *testing.T) {
+	baseFee := sdkmath.NewInt(1_000_000_000)
+	lowTip, highTip := sdkmath.NewInt(1_000_000), sdkmath.NewInt(5_000_000)
+
+	lowFees, lowPriority := CosmosTxArgs{BaseFee: &baseFee, Tip: &lowTip, Gas: 21000}.ComputeFeeAndPriority(baseFee)
+	highFees, highPriority := CosmosTxArgs{BaseFee: &baseFee, Tip: &highTip, Gas: 21000}.ComputeFeeAndPriority(baseFee)
+
+	require.Equal(t, sdk.Coins{{Denom: utils.BaseDenom, Amount: sdkmath.NewInt(21_021_000_000_000)}}, lowFees)
+	require.Equal(t, sdk.Coins{{Denom: utils.BaseDenom, Amount: sdkmath.NewInt(21_105_000_000_000)}}, highFees)
+	require.Equal(t, int64(1), lowPriority)
+	require.Equal(t, int64(5), highPriority)
+	require.Greater(t, highPriority, lowPriority)
+
+	// fees below the base fee get no priority
+	gasPrice := sdkmath.NewInt(1)
+	_, priority := CosmosTxArgs{GasPrice: &gasPrice, Gas: 21000}.ComputeFeeAndPriority(baseFee)
+	require.Zero(t, priority)
+}
@@ -22,10 +22,16 @@ import (
 	"github.com/artela-network/artela/ethereum/eip712"
 )
 
+// EIP712TxArgs contains the params to create a cosmos txs signed as EIP-712 typed data
 type EIP712TxArgs struct {
+	// CosmosTxArgs are the args of the cosmos txs. A SignMode of SIGN_MODE_LEGACY_AMINO_JSON
+	// is used for the signature, any other mode defaults to SIGN_MODE_DIRECT.
 	CosmosTxArgs       CosmosTxArgs
 	UseLegacyExtension bool
 	UseLegacyTypedData bool
+	// Domain overrides the domain of the typed data when set, it defaults to the
+	// domain derived from the chain id of the CosmosTxArgs
+	Domain *apitypes.TypedDataDomain
 }
 
 type typedDataArgs struct {
@@ -39,6 +45,7 @@ type signatureV2Args struct {
 	pubKey    cryptotypes.PubKey
 	signature []byte
 	nonce     uint64
+	signMode  signing.SignMode
 }
 
 type legacyWeb3ExtensionArgs struct {
@@ -87,10 +94,14 @@ func PrepareEIP712CosmosTx(
 		return nil, err
 	}
 
-	fee := legacytx.NewStdFee(txArgs.Gas, txArgs.Fees) // nolint: staticcheck
+	fees, err := txFees(txArgs)
+	if err != nil {
+		return nil, err
+	}
+	fee := legacytx.NewStdFee(txArgs.Gas, fees) // nolint: staticcheck
 
 	msgs := txArgs.Msgs
-	data := legacytx.StdSignBytes(ctx.ChainID(), accNumber, nonce, 0, fee, msgs, "", nil)
+	data := legacytx.StdSignBytes(ctx.ChainID(), accNumber, nonce, txArgs.TimeoutHeight, fee, msgs, txArgs.Memo, nil)
 
 	typedDataArgs := typedDataArgs{
 		chainID:        chainIDNum,
@@ -102,6 +113,9 @@ func PrepareEIP712CosmosTx(
 	if err != nil {
 		return nil, err
 	}
+	if args.Domain != nil {
+		typedData.Domain = *args.Domain
+	}
 
 	txBuilder := txArgs.TxCfg.NewTxBuilder()
 	builder, ok := txBuilder.(authtx.ExtensionOptionsTxBuilder)
@@ -111,6 +125,8 @@ func PrepareEIP712CosmosTx(
 
 	builder.SetFeeAmount(fee.Amount)
 	builder.SetGasLimit(txArgs.Gas)
+	builder.SetMemo(txArgs.Memo)
+	builder.SetTimeoutHeight(txArgs.TimeoutHeight)
 
 	err = builder.SetMsgs(txArgs.Msgs...)
 	if err != nil {
@@ -145,17 +161,10 @@ func signCosmosEIP712Tx(
 		return nil, err
 	}
 
-	sigHash, _, err := apitypes.TypedDataAndHash(data)
-	if err != nil {
-		return nil, err
-	}
-
-	keyringSigner := NewSigner(priv)
-	signature, pubKey, err := keyringSigner.SignByAddress(from, sigHash)
+	signature, pubKey, err := SignEIP712TypedData(priv, data)
 	if err != nil {
 		return nil, err
 	}
-	signature[crypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
 
 	if args.UseLegacyExtension {
 		if err := setBuilderLegacyWeb3Extension(
@@ -174,6 +183,7 @@ func signCosmosEIP712Tx(
 			pubKey:    pubKey,
 			signature: signature,
 			nonce:     nonce,
+			signMode:  args.CosmosTxArgs.SignMode,
 		},
 		args.UseLegacyExtension,
 	)
@@ -186,6 +196,24 @@ func signCosmosEIP712Tx(
 	return builder, nil
 }
 
+// SignEIP712TypedData signs the hash of the typed data with the provided private key, used
+// as an eth key. The V of the returned signature is 27/28, as expected by the web3 wallets.
+func SignEIP712TypedData(priv cryptotypes.PrivKey, data apitypes.TypedData) ([]byte, cryptotypes.PubKey, error) {
+	sigHash, _, err := apitypes.TypedDataAndHash(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyringSigner := NewSigner(priv)
+	signature, pubKey, err := keyringSigner.SignByAddress(sdk.AccAddress(priv.PubKey().Address().Bytes()), sigHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	signature[crypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
+
+	return signature, pubKey, nil
+}
+
 // createTypedData creates the TypedData object corresponding to
 // the arguments, using the legacy implementation as specified.
 func createTypedData(args typedDataArgs, useLegacy bool) (apitypes.TypedData, error) {
@@ -242,10 +270,15 @@ func getTxSignatureV2(args signatureV2Args, useLegacyExtension bool) signing.Sig
 
 	// Must use SIGN_MODE_DIRECT, since Amino has some trouble parsing certain Any values from a SignDoc
 	// with the Legacy EIP-712 TypedData encodings. This is not an issue with the latest encoding.
+	signMode := signing.SignMode_SIGN_MODE_DIRECT
+	if args.signMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+		signMode = args.signMode
+	}
+
 	return signing.SignatureV2{
 		PubKey: args.pubKey,
 		Data: &signing.SingleSignatureData{
-			SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
+			SignMode:  signMode,
 			Signature: args.signature,
 		},
 		Sequence: args.nonce,
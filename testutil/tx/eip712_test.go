@@ -0,0 +1,51 @@
+package tx
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/ethereum/eip712"
+	"github.com/artela-network/artela/ethereum/utils"
+)
+
+func recoverEIP712Signer(t *testing.T, data apitypes.TypedData, signature []byte) common.Address {
+	sigHash, _, err := apitypes.TypedDataAndHash(data)
+	require.NoError(t, err)
+
+	sig := common.CopyBytes(signature)
+	sig[crypto.RecoveryIDOffset] -= 27
+	pubKey, err := crypto.SigToPub(sigHash, sig)
+	require.NoError(t, err)
+	return crypto.PubkeyToAddress(*pubKey)
+}
+
+func TestSignEIP712TypedData(t *testing.T) {
+	addr, priv := NewAddrKey()
+	to := GenerateAddress()
+
+	msg := banktypes.NewMsgSend(addr.Bytes(), to.Bytes(), sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(1))))
+	fee := legacytx.NewStdFee(200000, sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(20)))) // nolint: staticcheck
+	data := legacytx.StdSignBytes("artela_11820-1", 1, 0, 0, fee, []sdk.Msg{msg}, "memo", nil)
+
+	typedData, err := eip712.WrapTxToTypedData(11820, data)
+	require.NoError(t, err)
+
+	signature, pubKey, err := SignEIP712TypedData(priv, typedData)
+	require.NoError(t, err)
+	require.Equal(t, priv.PubKey(), pubKey)
+	require.Contains(t, []byte{27, 28}, signature[crypto.RecoveryIDOffset])
+	require.Equal(t, addr, recoverEIP712Signer(t, typedData, signature))
+
+	// the signature is bound to the domain
+	otherDomain := typedData
+	otherDomain.Domain.Name = "Other"
+	require.NotEqual(t, addr, recoverEIP712Signer(t, otherDomain, signature))
+}
@@ -23,6 +23,82 @@ import (
 	"github.com/artela-network/artela/app"
 )
 
+// EthTxArgs contains the params to create an ethereum txs
+type EthTxArgs struct {
+	// ChainID is the chain's id on ethereum format, defaults to the evm keeper chain id
+	ChainID *big.Int
+	// Nonce is the account sequence of the sender
+	Nonce uint64
+	// To is the recipient of the txs, nil for contract creations
+	To *common.Address
+	// Amount is the value transferred with the txs
+	Amount *big.Int
+	// GasLimit to be used on the txs
+	GasLimit uint64
+	// GasPrice to be used on legacy and access list txs
+	GasPrice *big.Int
+	// GasFeeCap and GasTipCap are used on dynamic fee txs
+	GasFeeCap *big.Int
+	GasTipCap *big.Int
+	// Data is the txs input data
+	Data []byte
+	// Accesses is the optional access list of the txs
+	Accesses *ethtypes.AccessList
+}
+
+// PrepareEthTxWithArgs creates a MsgEthereumTx from the provided args, signs it with the
+// private key and wraps it into a cosmos txs ready to be broadcast. A dynamic fee txs is
+// built when GasFeeCap or GasTipCap is set, an access list txs when only GasPrice and an
+// access list are set, and a legacy txs otherwise.
+// It returns the signed txs and an error
+func PrepareEthTxWithArgs(
+	txCfg client.TxConfig,
+	appArtela *app.Artela,
+	priv cryptotypes.PrivKey,
+	args EthTxArgs,
+) (authsigning.Tx, error) {
+	if priv == nil {
+		return nil, errorsmod.Wrapf(errorsmod.Error{}, "private key is required to sign Ethereum txs")
+	}
+
+	chainID := args.ChainID
+	if chainID == nil {
+		chainID = appArtela.EvmKeeper.ChainID()
+	}
+
+	evmTxParams := &txs.EvmTxArgs{
+		ChainID:  chainID,
+		Nonce:    args.Nonce,
+		To:       args.To,
+		Amount:   args.Amount,
+		GasLimit: args.GasLimit,
+		Input:    args.Data,
+		Accesses: args.Accesses,
+	}
+
+	if args.GasFeeCap != nil || args.GasTipCap != nil {
+		evmTxParams.GasFeeCap = args.GasFeeCap
+		evmTxParams.GasTipCap = args.GasTipCap
+		if evmTxParams.GasFeeCap == nil {
+			evmTxParams.GasFeeCap = args.GasTipCap
+		}
+		if evmTxParams.GasTipCap == nil {
+			evmTxParams.GasTipCap = big.NewInt(0)
+		}
+		// a non nil access list is required to build a dynamic fee txs
+		if evmTxParams.Accesses == nil {
+			evmTxParams.Accesses = &ethtypes.AccessList{}
+		}
+	} else {
+		evmTxParams.GasPrice = args.GasPrice
+	}
+
+	msgEthereumTx := txs.NewTx(evmTxParams)
+	msgEthereumTx.From = common.BytesToAddress(priv.PubKey().Address().Bytes()).String()
+
+	return PrepareEthTx(txCfg, appArtela, priv, msgEthereumTx)
+}
+
 // PrepareEthTx creates an ethereum txs and signs it with the provided messages and private key.
 // It returns the signed txs and an error
 func PrepareEthTx(
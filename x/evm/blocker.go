@@ -22,7 +22,7 @@ func BeginBlock(_ cosmos.Context, k *keeper.Keeper, beginBlock abci.RequestBegin
 }
 
 // EndBlock also retrieves the bloom filter value from the transient store and commits it to the
-// KVStore. The EVM end block logic doesn't update the validator set, thus it returns
+// KVStore bloom bits index. The EVM end block logic doesn't update the validator set, thus it returns
 // an empty slice.
 func EndBlock(ctx cosmos.Context, k *keeper.Keeper, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	// Aspect Runtime Context Lifecycle: destory ExtBlockContext
@@ -33,6 +33,9 @@ func EndBlock(ctx cosmos.Context, k *keeper.Keeper, _ abci.RequestEndBlock) []ab
 
 	bloom := ethereum.BytesToBloom(k.GetBlockBloomTransient(infCtx).Bytes())
 	k.EmitBlockBloomEvent(infCtx, bloom)
+	if err := k.IndexBlockBloom(infCtx, bloom); err != nil {
+		k.Logger(ctx).Error("failed to index block bloom", "height", ctx.BlockHeight(), "error", err)
+	}
 
 	return []abci.ValidatorUpdate{}
 }
@@ -3,6 +3,7 @@ package evm
 import (
 	"bytes"
 	"fmt"
+	"math/big"
 
 	artela "github.com/artela-network/artela/ethereum/types"
 	"github.com/artela-network/artela/x/evm/txs/support"
@@ -33,6 +34,13 @@ func InitGenesis(
 		panic("the EVM module account has not been set")
 	}
 
+	// the bank module is initialized before, so the balances of the accounts can be reconciled
+	if err := genState.ValidateBalances(func(address common.Address) *big.Int {
+		return k.GetBalance(ctx, address)
+	}); err != nil {
+		panic(err)
+	}
+
 	for _, account := range genState.Accounts {
 		address := common.HexToAddress(account.Address)
 		accAddress := cosmos.AccAddress(address.Bytes())
@@ -62,6 +70,9 @@ func InitGenesis(
 		}
 
 		k.SetCode(ctx, codeHash.Bytes(), code)
+		if len(code) != 0 {
+			k.SetContractCodeHash(ctx, address, codeHash)
+		}
 
 		for _, storage := range account.Storage {
 			k.SetState(ctx, address, common.HexToHash(storage.Key), common.HexToHash(storage.Value).Bytes())
@@ -84,6 +95,8 @@ func ExportGenesis(ctx cosmos.Context, k *keeper.Keeper, ak types.AccountKeeper)
 		addr := ethAccount.EthAddress()
 
 		storage := k.GetAccountStorage(ctx, addr)
+		// sort the storage to export the same genesis on every node
+		support.SortStates(storage)
 
 		genAccount := support.GenesisAccount{
 			Address: addr.String(),
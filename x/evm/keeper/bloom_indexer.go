@@ -0,0 +1,266 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	"github.com/ethereum/go-ethereum/core/bloombits"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/artela-network/artela/x/evm/txs/support"
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+// fullBloom is used for the blocks without a stored bloom, e.g. the blocks committed before
+// the indexer was enabled, so they match any filter.
+var fullBloom = func() ethereum.Bloom {
+	var bloom ethereum.Bloom
+	for i := range bloom {
+		bloom[i] = 0xff
+	}
+	return bloom
+}()
+
+// BloomIndexer maintains a section based bloom bits index, à la go-ethereum's bloombits.
+// The bloom of every block is stored until its section of sectionSize blocks is complete,
+// then the blooms of the section are rotated into 2048 bit vectors, one per bloom bit,
+// so a filter only has to read the 3 vectors of each of its keys per section instead of
+// every block bloom.
+//
+// The first and next sections to index are recorded in the store along with the index,
+// so indexing resumes where it stopped after a restart.
+type BloomIndexer struct {
+	sectionSize uint64
+}
+
+// NewBloomIndexer creates a new BloomIndexer with the given section size, which must be a
+// non-zero multiple of 8.
+func NewBloomIndexer(sectionSize uint64) *BloomIndexer {
+	if sectionSize == 0 || sectionSize%8 != 0 {
+		panic(fmt.Sprintf("invalid bloom section size %d, must be a non-zero multiple of 8", sectionSize))
+	}
+	return &BloomIndexer{sectionSize: sectionSize}
+}
+
+// SectionSize returns the number of blocks of a section.
+func (bi *BloomIndexer) SectionSize() uint64 {
+	return bi.sectionSize
+}
+
+// Sections returns the first indexed section and the next section to index. The first
+// section is the one the indexer has been enabled at, the blocks before are not indexed.
+func (bi *BloomIndexer) Sections(store storetypes.KVStore) (first, next uint64, ok bool) {
+	bz := store.Get(types.KeyPrefixBloomSections)
+	if len(bz) != 16 {
+		return 0, 0, false
+	}
+	return binary.BigEndian.Uint64(bz[:8]), binary.BigEndian.Uint64(bz[8:]), true
+}
+
+func (bi *BloomIndexer) setSections(store storetypes.KVStore, first, next uint64) {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz[:8], first)
+	binary.BigEndian.PutUint64(bz[8:], next)
+	store.Set(types.KeyPrefixBloomSections, bz)
+}
+
+// AddBloom stores the bloom of the block at the given height and indexes the sections
+// completed by it.
+func (bi *BloomIndexer) AddBloom(store storetypes.KVStore, height uint64, bloom ethereum.Bloom) error {
+	first, next, ok := bi.Sections(store)
+	if !ok {
+		// index from the section of the first added block on
+		first = height / bi.sectionSize
+		next = first
+	}
+
+	prefix.NewStore(store, types.KeyPrefixBlockBloom).Set(cosmos.Uint64ToBigEndian(height), bloom.Bytes())
+
+	for ; next < (height+1)/bi.sectionSize; next++ {
+		if err := bi.indexSection(store, next); err != nil {
+			return err
+		}
+	}
+	bi.setSections(store, first, next)
+	return nil
+}
+
+// indexSection rotates the blooms of the section into bit vectors and deletes them.
+func (bi *BloomIndexer) indexSection(store storetypes.KVStore, section uint64) error {
+	gen, err := bloombits.NewGenerator(uint(bi.sectionSize))
+	if err != nil {
+		return err
+	}
+
+	blooms := prefix.NewStore(store, types.KeyPrefixBlockBloom)
+	for i := uint64(0); i < bi.sectionSize; i++ {
+		key := cosmos.Uint64ToBigEndian(section*bi.sectionSize + i)
+		bloom := fullBloom
+		if bz := blooms.Get(key); bz != nil {
+			bloom = ethereum.BytesToBloom(bz)
+		}
+		if err := gen.AddBloom(uint(i), bloom); err != nil {
+			return fmt.Errorf("failed to add bloom of block %d: %w", section*bi.sectionSize+i, err)
+		}
+		blooms.Delete(key)
+	}
+
+	bits := prefix.NewStore(store, types.KeyPrefixBloomBits)
+	for bit := uint(0); bit < ethereum.BloomBitLength; bit++ {
+		vector, err := gen.Bitset(bit)
+		if err != nil {
+			return fmt.Errorf("failed to generate bit %d of section %d: %w", bit, section, err)
+		}
+		bits.Set(bloomBitsKey(bit, section), bitutil.CompressBytes(vector))
+	}
+	return nil
+}
+
+// Candidates returns the heights within [from, to] of the blocks that may contain logs
+// matching the addresses and topics, following the eth_getLogs filter rules. The indexed
+// sections are matched against their bit vectors and the other blocks against their stored
+// bloom. Blocks without any stored bloom are always returned.
+func (bi *BloomIndexer) Candidates(
+	store storetypes.KVStore,
+	from, to uint64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+) ([]uint64, error) {
+	if from > to {
+		return nil, fmt.Errorf("invalid block range [%d, %d]", from, to)
+	}
+
+	clauses := bloomClauses(addresses, topics)
+	first, next, _ := bi.Sections(store)
+	blooms := prefix.NewStore(store, types.KeyPrefixBlockBloom)
+
+	candidates := make([]uint64, 0)
+	for height := from; ; {
+		section := height / bi.sectionSize
+		last := height
+		if section >= first && section < next {
+			matches, err := bi.matchSection(store, section, clauses)
+			if err != nil {
+				return nil, err
+			}
+
+			last = (section+1)*bi.sectionSize - 1
+			if last > to {
+				last = to
+			}
+			for h := height; h <= last; h++ {
+				i := h - section*bi.sectionSize
+				if matches == nil || matches[i/8]&(1<<(7-i%8)) != 0 {
+					candidates = append(candidates, h)
+				}
+			}
+		} else {
+			bz := blooms.Get(cosmos.Uint64ToBigEndian(height))
+			if bz == nil || support.BloomMatches(ethereum.BytesToBloom(bz), addresses, topics) {
+				candidates = append(candidates, height)
+			}
+		}
+
+		if last >= to {
+			break
+		}
+		height = last + 1
+	}
+	return candidates, nil
+}
+
+// matchSection returns the bit vector of the blocks of the section matching all the
+// clauses, or nil if there are no clauses to match.
+func (bi *BloomIndexer) matchSection(store storetypes.KVStore, section uint64, clauses [][][3]uint) ([]byte, error) {
+	bits := prefix.NewStore(store, types.KeyPrefixBloomBits)
+	vectors := make(map[uint][]byte)
+	vector := func(bit uint) ([]byte, error) {
+		if v, ok := vectors[bit]; ok {
+			return v, nil
+		}
+		bz := bits.Get(bloomBitsKey(bit, section))
+		if bz == nil {
+			return nil, fmt.Errorf("bit %d of section %d not found", bit, section)
+		}
+		v, err := bitutil.DecompressBytes(bz, int(bi.sectionSize/8))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress bit %d of section %d: %w", bit, section, err)
+		}
+		vectors[bit] = v
+		return v, nil
+	}
+
+	var matches []byte
+	for _, clause := range clauses {
+		// a clause matches if any of its keys matches, i.e. all the 3 bits of the key are set
+		clauseMatches := make([]byte, bi.sectionSize/8)
+		for _, key := range clause {
+			keyMatches := make([]byte, bi.sectionSize/8)
+			for i := range keyMatches {
+				keyMatches[i] = 0xff
+			}
+			for _, bit := range key {
+				v, err := vector(bit)
+				if err != nil {
+					return nil, err
+				}
+				bitutil.ANDBytes(keyMatches, keyMatches, v)
+			}
+			bitutil.ORBytes(clauseMatches, clauseMatches, keyMatches)
+		}
+
+		if matches == nil {
+			matches = clauseMatches
+		} else {
+			bitutil.ANDBytes(matches, matches, clauseMatches)
+		}
+	}
+	return matches, nil
+}
+
+// bloomClauses returns the bloom bit indexes of the keys of each non wildcard filter clause.
+func bloomClauses(addresses []common.Address, topics [][]common.Hash) [][][3]uint {
+	var clauses [][][3]uint
+	if len(addresses) > 0 {
+		clause := make([][3]uint, len(addresses))
+		for i, addr := range addresses {
+			clause[i] = bloomBitIndexes(addr.Bytes())
+		}
+		clauses = append(clauses, clause)
+	}
+	for _, sub := range topics {
+		if len(sub) == 0 {
+			continue // empty rule set == wildcard
+		}
+		clause := make([][3]uint, len(sub))
+		for i, topic := range sub {
+			clause[i] = bloomBitIndexes(topic.Bytes())
+		}
+		clauses = append(clauses, clause)
+	}
+	return clauses
+}
+
+// bloomBitIndexes returns the 3 bloom bits set by the given key.
+func bloomBitIndexes(key []byte) [3]uint {
+	hash := crypto.Keccak256(key)
+	var idxs [3]uint
+	for i := range idxs {
+		idxs[i] = (uint(hash[2*i])<<8)&2047 + uint(hash[2*i+1])
+	}
+	return idxs
+}
+
+// bloomBitsKey returns the key of the bit vector of the given bloom bit and section.
+func bloomBitsKey(bit uint, section uint64) []byte {
+	key := make([]byte, 10)
+	binary.BigEndian.PutUint16(key[:2], uint16(bit))
+	binary.BigEndian.PutUint64(key[2:], section)
+	return key
+}
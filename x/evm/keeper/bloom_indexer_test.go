@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testAddrA  = common.HexToAddress("0xa")
+	testAddrB  = common.HexToAddress("0xb")
+	testTopic1 = common.HexToHash("0x1")
+	testTopic2 = common.HexToHash("0x2")
+)
+
+func testBloom(logs ...*ethereum.Log) ethereum.Bloom {
+	return ethereum.BytesToBloom(ethereum.LogsBloom(logs))
+}
+
+func heights(from, to uint64) []uint64 {
+	res := make([]uint64, 0, to-from+1)
+	for h := from; h <= to; h++ {
+		res = append(res, h)
+	}
+	return res
+}
+
+func TestBloomIndexerCandidates(t *testing.T) {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	bi := NewBloomIndexer(8)
+
+	blooms := map[uint64]ethereum.Bloom{
+		3:  testBloom(&ethereum.Log{Address: testAddrA, Topics: []common.Hash{testTopic1}}),
+		12: testBloom(&ethereum.Log{Address: testAddrA, Topics: []common.Hash{testTopic2}}),
+		17: testBloom(&ethereum.Log{Address: testAddrB, Topics: []common.Hash{testTopic1}}),
+	}
+	for h := uint64(1); h <= 20; h++ {
+		require.NoError(t, bi.AddBloom(store, h, blooms[h]))
+	}
+
+	// sections [0, 8) and [8, 16) are indexed, the blocks from 16 on are pending
+	first, next, ok := bi.Sections(store)
+	require.True(t, ok)
+	require.Equal(t, uint64(0), first)
+	require.Equal(t, uint64(2), next)
+
+	// block 0 has no bloom so it is always a candidate
+	testCases := []struct {
+		name      string
+		addresses []common.Address
+		topics    [][]common.Hash
+		expected  []uint64
+	}{
+		{"wildcard", nil, nil, heights(0, 20)},
+		{"address", []common.Address{testAddrA}, nil, []uint64{0, 3, 12}},
+		{"topic", nil, [][]common.Hash{{testTopic1}}, []uint64{0, 3, 17}},
+		{"any address", []common.Address{testAddrA, testAddrB}, nil, []uint64{0, 3, 12, 17}},
+		{"address and topic", []common.Address{testAddrA}, [][]common.Hash{{testTopic2}}, []uint64{0, 12}},
+		{"no match", []common.Address{common.HexToAddress("0xc")}, nil, []uint64{0}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			candidates, err := bi.Candidates(store, 0, 20, tc.addresses, tc.topics)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, candidates)
+		})
+	}
+
+	// a sub range across the indexed and pending blocks
+	candidates, err := bi.Candidates(store, 4, 17, nil, [][]common.Hash{{testTopic1}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{17}, candidates)
+
+	_, err = bi.Candidates(store, 2, 1, nil, nil)
+	require.Error(t, err)
+}
+
+func TestBloomIndexerResume(t *testing.T) {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	for h := uint64(1); h <= 10; h++ {
+		require.NoError(t, NewBloomIndexer(8).AddBloom(store, h, ethereum.Bloom{}))
+	}
+
+	// a new indexer, e.g. after a restart, resumes from the recorded sections
+	bi := NewBloomIndexer(8)
+	require.NoError(t, bi.AddBloom(store, 11, testBloom(&ethereum.Log{Address: testAddrA})))
+	for h := uint64(12); h <= 16; h++ {
+		require.NoError(t, bi.AddBloom(store, h, ethereum.Bloom{}))
+	}
+
+	first, next, _ := bi.Sections(store)
+	require.Equal(t, uint64(0), first)
+	require.Equal(t, uint64(2), next)
+
+	candidates, err := bi.Candidates(store, 1, 16, []common.Address{testAddrA}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{11}, candidates)
+}
+
+func TestBloomIndexerStartedMidChain(t *testing.T) {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	bi := NewBloomIndexer(8)
+	for h := uint64(13); h <= 17; h++ {
+		require.NoError(t, bi.AddBloom(store, h, ethereum.Bloom{}))
+	}
+
+	first, next, _ := bi.Sections(store)
+	require.Equal(t, uint64(1), first)
+	require.Equal(t, uint64(2), next)
+
+	// the blocks committed before the indexer was enabled have no bloom and always match
+	candidates, err := bi.Candidates(store, 0, 17, []common.Address{testAddrA}, nil)
+	require.NoError(t, err)
+	require.Equal(t, heights(0, 12), candidates)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"errors"
+	"fmt"
 	"math/big"
 
 	asptypes "github.com/artela-network/aspect-core/types"
@@ -14,12 +15,12 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	artcore "github.com/artela-network/artela-evm/core"
+	"github.com/artela-network/artela-evm/tracers/logger"
 	"github.com/artela-network/artela-evm/vm"
 	cosmos "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethereum "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 
 	artela "github.com/artela-network/artela/ethereum/types"
@@ -45,13 +46,14 @@ func (k *Keeper) NewEVM(
 		Transfer:    artcore.Transfer,
 		GetHash:     k.GetHashFn(ctx),
 		Coinbase:    cfg.CoinBase,
-		GasLimit:    artela.BlockGasLimit(ctx),
+		GasLimit:    cfg.Params.EffectiveBlockGasLimit(artela.BlockGasLimit(ctx)),
 		BlockNumber: big.NewInt(ctx.BlockHeight()),
 		Time:        uint64(ctx.BlockHeader().Time.Unix()),
 		Difficulty:  big.NewInt(0), // unused. Only required in PoW context
 		BaseFee:     cfg.BaseFee,
 		Random:      nil, // not supported
 	}
+	cfg.BlockOverrides.Apply(&blockCtx)
 
 	txCtx := artcore.NewEVMTxContext(msg)
 	if tracer == nil {
@@ -204,7 +206,7 @@ func (k *Keeper) ApplyTransaction(ctx cosmos.Context, tx *ethereum.Transaction)
 
 	var contractAddr common.Address
 	if msg.To == nil {
-		contractAddr = crypto.CreateAddress(msg.From, msg.Nonce)
+		contractAddr = support.ComputeContractAddress(msg.From, msg.Nonce)
 	}
 
 	receipt := &ethereum.Receipt{
@@ -224,6 +226,15 @@ func (k *Keeper) ApplyTransaction(ctx cosmos.Context, tx *ethereum.Transaction)
 	if !res.Failed() {
 		receipt.Status = ethereum.ReceiptStatusSuccessful
 
+		// the hooks run on the cache context of the txs, an error discards its states changes
+		if err := k.postTxProcessing(tmpCtx, tx, msg, res, contractAddr); err != nil {
+			res.VmError = errorsmod.Wrap(err, "failed to execute post processing").Error()
+			receipt.Status = ethereum.ReceiptStatusFailed
+			receipt.Logs = nil
+			res.Logs = nil
+			commit = nil
+		}
+
 		if commit != nil {
 			commit()
 			res.Logs = support.NewLogsFromEth(receipt.Logs)
@@ -257,6 +268,47 @@ func (k *Keeper) ApplyTransaction(ctx cosmos.Context, tx *ethereum.Transaction)
 	return res, nil
 }
 
+// prepareAccessList prepares the access list of the message once Berlin is activated, with
+// regards to EIP-2929 and EIP-2930. Once Shanghai is activated, the coinbase is warmed up as
+// well (EIP-3651).
+func (k *Keeper) prepareAccessList(ctx cosmos.Context, stateDB *states.StateDB, msg *core.Message, cfg *states.EVMConfig) {
+	height := big.NewInt(ctx.BlockHeight())
+	rules := cfg.ChainConfig.Rules(height, cfg.ChainConfig.MergeNetsplitBlock != nil, uint64(ctx.BlockTime().Unix()))
+	if !rules.IsBerlin {
+		return
+	}
+
+	stateDB.PrepareAccessList(msg.From, msg.To, k.ActivePrecompiles(rules), msg.AccessList)
+	if cfg.Params.IsEIPActivated(3651, height) {
+		stateDB.AddAddressToAccessList(cfg.CoinBase)
+	}
+}
+
+// postTxProcessing calls the EVM hooks with the message and the result of the txs.
+func (k *Keeper) postTxProcessing(
+	ctx cosmos.Context,
+	tx *ethereum.Transaction,
+	msg *core.Message,
+	res *txs.MsgEthereumTxResponse,
+	contractAddr common.Address,
+) error {
+	if k.hooks == nil {
+		return nil
+	}
+
+	ethMsg := &txs.MsgEthereumTx{From: msg.From.Hex()}
+	if err := ethMsg.FromEthereumTx(tx); err != nil {
+		return err
+	}
+
+	var contractAddress *common.Address
+	if msg.To == nil {
+		contractAddress = &contractAddr
+	}
+	result := res.ToTxResult(contractAddress)
+	return k.hooks.PostTxProcessing(ctx, ethMsg, &result)
+}
+
 // ApplyMessage calls ApplyMessageWithConfig with an empty TxConfig.
 func (k *Keeper) ApplyMessage(ctx cosmos.Context, msg *core.Message, tracer vm.EVMLogger, commit bool) (*txs.MsgEthereumTxResponse, error) {
 
@@ -334,8 +386,28 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
 	}
 
+	// EIP3860(limit and meter initcode): https://eips.ethereum.org/EIPS/eip-3860
+	if msg.To == nil {
+		if err := cfg.Params.ValidateInitCodeSize(msg.Data, big.NewInt(ctx.BlockHeight())); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to create new contract")
+		}
+	}
+
+	// the opcodes blocked by the params are guarded on each step of the interpreter
+	var guard *states.OpcodeGuard
+	if len(cfg.Params.BlockedOpcodes) > 0 {
+		if tracer == nil {
+			tracer = k.Tracer(ctx, msg, cfg.ChainConfig)
+		}
+		guard = states.NewOpcodeGuard(cfg.Params.BlockedOpcodes, tracer)
+		tracer = guard
+	}
+
 	stateDB := states.New(ctx, k, txConfig)
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
+	if cfg.OnNewEVM != nil {
+		cfg.OnNewEVM(evm)
+	}
 
 	// Aspect Runtime Context Lifecycle: set EVM params.
 	// Before the pre-transaction execution, establish the EVM context, encompassing details such as
@@ -350,14 +422,15 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 
 	leftoverGas := msg.GasLimit
 
-	// Allow the tracer captures the txs level events, mainly the gas consumption.
-	// evmCfg := evm.Config
-	// if evmCfg.Debug {
-	//	evmCfg.Tracer.CaptureTxStart(leftoverGas)
-	//	defer func() {
-	//		evmCfg.Tracer.CaptureTxEnd(leftoverGas)
-	//	}()
-	// }
+	// Allow the tracer captures the txs level events, mainly the gas consumption. The txs end is
+	// captured before the dirty states are discarded, so tracers like the prestate tracer in diff
+	// mode are able to read the post states from the StateDB.
+	if tracer != nil {
+		tracer.CaptureTxStart(leftoverGas)
+		defer func() {
+			tracer.CaptureTxEnd(leftoverGas)
+		}()
+	}
 
 	sender := vm.AccountRef(msg.From)
 	contractCreation := msg.To == nil
@@ -378,9 +451,7 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 
 	// access list preparation is moved from ante handler to here, because it's needed when `ApplyMessage` is called
 	// under contexts where ante handlers are not run, for example `eth_call` and `eth_estimateGas`.
-	if rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil, uint64(ctx.BlockTime().Unix())); rules.IsBerlin {
-		stateDB.PrepareAccessList(msg.From, msg.To, vm.ActivePrecompiles(rules), msg.AccessList)
-	}
+	k.prepareAccessList(ctx, stateDB, msg, cfg)
 	lastHeight := uint64(ctx.BlockHeight())
 	// if transaction is Aspect operational, short the circuit and skip the processes
 	if isAspectOpTx := asptypes.IsAspectContractAddr(msg.To); isAspectOpTx {
@@ -396,7 +467,11 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 		// - reset sender's nonce to msg.Nonce() before calling evm.
 		// - increase sender's nonce by one no matter the result.
 		stateDB.SetNonce(sender.Address(), msg.Nonce)
+		snapshot := stateDB.Snapshot()
 		ret, _, leftoverGas, vmErr = evm.Create(aspectCtx, sender, msg.Data, leftoverGas, msg.Value)
+		if guard != nil {
+			ret, leftoverGas, vmErr = guard.Result(stateDB, snapshot, ret, leftoverGas, vmErr)
+		}
 		stateDB.SetNonce(sender.Address(), msg.Nonce+1)
 	} else {
 		// begin pre tx aspect execution
@@ -419,8 +494,16 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 				vmErr = preTxResult.Err
 			}
 		} else {
-			// execute evm call
-			ret, leftoverGas, vmErr = evm.Call(aspectCtx, sender, *msg.To, msg.Data, leftoverGas, msg.Value)
+			// execute evm call, custom precompiles take precedence over the standard ones
+			if _, ok := k.precompiles.Get(*msg.To); ok {
+				ret, leftoverGas, vmErr = k.precompiles.Call(evm, msg.From, *msg.To, msg.Data, leftoverGas, msg.Value, false)
+			} else {
+				snapshot := stateDB.Snapshot()
+				ret, leftoverGas, vmErr = evm.Call(aspectCtx, sender, *msg.To, msg.Data, leftoverGas, msg.Value)
+				if guard != nil {
+					ret, leftoverGas, vmErr = guard.Result(stateDB, snapshot, ret, leftoverGas, vmErr)
+				}
+			}
 			status := ethereum.ReceiptStatusSuccessful
 			if vmErr != nil {
 				status = ethereum.ReceiptStatusFailed
@@ -529,10 +612,100 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 	leftoverGas = msg.GasLimit - gasUsed
 
 	return &txs.MsgEthereumTxResponse{
-		GasUsed: gasUsed,
-		VmError: vmError,
-		Ret:     ret,
-		Logs:    support.NewLogsFromEth(stateDB.Logs()),
-		Hash:    txConfig.TxHash.Hex(),
+		GasUsed:     gasUsed,
+		GasRefunded: refund,
+		VmError:     vmError,
+		Ret:         ret,
+		Logs:        support.NewLogsFromEth(stateDB.Logs()),
+		Hash:        txConfig.TxHash.Hex(),
 	}, nil
 }
+
+// EstimateGasWithConfig returns the lowest gas limit allowing the message to execute successfully,
+// binary searching between the intrinsic gas of the message and the gas cap. Plain value transfers
+// to non-contract accounts short-circuit to 21000. If prewarmAccessList is set, the message is
+// first executed with an access list tracer, and the resulting access list is used to avoid
+// over-estimating the cost of cold accesses. The message is executed without committing the
+// StateDB, and an error describing the revert is returned if it still fails at the gas cap.
+func (k *Keeper) EstimateGasWithConfig(
+	ctx cosmos.Context,
+	aspectCtx *artelatypes.AspectRuntimeContext,
+	msg *core.Message,
+	cfg *states.EVMConfig,
+	txConfig states.TxConfig,
+	gasCap uint64,
+	prewarmAccessList bool,
+) (uint64, error) {
+	if gasCap < params.TxGas {
+		return 0, fmt.Errorf("gas cap cannot be lower than %d", params.TxGas)
+	}
+
+	// Create a helper to check if a gas allowance results in an executable txs
+	executable := func(gas uint64) (vmError bool, rsp *txs.MsgEthereumTxResponse, err error) {
+		// update the message with the new gas value
+		msg.GasLimit = gas
+		// pass false to not commit StateDB
+		rsp, err = k.ApplyMessageWithConfig(ctx, aspectCtx, msg, nil, false, cfg, txConfig)
+		if err != nil {
+			if errors.Is(err, core.ErrIntrinsicGas) {
+				return true, nil, nil // Special case, raise gas limit
+			}
+			return true, nil, err // Bail out
+		}
+		return len(rsp.VmError) > 0, rsp, nil
+	}
+
+	// a plain value transfer to an account without code always costs 21000
+	if msg.To != nil && len(msg.Data) == 0 && len(msg.AccessList) == 0 {
+		if acct := k.GetAccount(ctx, *msg.To); acct == nil || !acct.IsContract() {
+			failed, _, err := executable(params.TxGas)
+			if err == nil && !failed {
+				return params.TxGas, nil
+			}
+		}
+	}
+
+	if prewarmAccessList && msg.To != nil {
+		rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil, uint64(ctx.BlockTime().Unix()))
+		tracer := logger.NewAccessListTracer(msg.AccessList, msg.From, *msg.To, k.ActivePrecompiles(rules))
+		msg.GasLimit = gasCap
+		if _, err := k.ApplyMessageWithConfig(ctx, aspectCtx, msg, tracer, false, cfg, txConfig); err == nil {
+			msg.AccessList = tracer.AccessList()
+		}
+	}
+
+	// the intrinsic gas of the message is the lowest possible gas limit
+	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, msg.To == nil)
+	if err != nil {
+		return 0, err
+	}
+	if intrinsicGas > gasCap {
+		return 0, fmt.Errorf("intrinsic gas too low: have %d, want %d", gasCap, intrinsicGas)
+	}
+
+	// Execute the binary search and hone in on an executable gas limit
+	hi, err := txs.BinSearch(intrinsicGas-1, gasCap, executable)
+	if err != nil {
+		return 0, err
+	}
+
+	// Reject the txs as invalid if it still fails at the highest allowance
+	if hi == gasCap {
+		failed, result, err := executable(hi)
+		if err != nil {
+			return 0, err
+		}
+
+		if failed {
+			if result != nil && !errors.Is(result.Err(), types.ErrOutOfGas) {
+				if errors.Is(result.Err(), types.ErrExecutionReverted) {
+					return 0, types.NewExecErrorWithReason(result.Ret)
+				}
+				return 0, result.Err()
+			}
+			// Otherwise, the specified gas cap is too low
+			return 0, fmt.Errorf("gas required exceeds allowance (%d)", gasCap)
+		}
+	}
+	return hi, nil
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/states"
+	"github.com/artela-network/artela/x/evm/txs/support"
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+func TestPrepareAccessListWarmCoinbase(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey(types.TransientKey))
+	k := Keeper{storeKey: storeKey, precompiles: states.NewPrecompileRegistry()}
+
+	params := support.DefaultParams()
+	shanghaiBlock := sdkmath.NewInt(10)
+	params.ChainConfig.ShanghaiBlock = &shanghaiBlock
+	cfg := &states.EVMConfig{
+		Params:      params,
+		ChainConfig: params.ChainConfig.EthereumConfig(big.NewInt(1)),
+		CoinBase:    common.HexToAddress("0xc0ffee"),
+	}
+	msg := &core.Message{From: common.HexToAddress("0x1")}
+
+	testCases := []struct {
+		height int64
+		warm   bool
+	}{
+		{9, false},
+		{10, true},
+	}
+	for _, tc := range testCases {
+		ctx := ctx.WithBlockHeight(tc.height)
+		stateDB := states.New(ctx, &k, states.NewEmptyTxConfig(common.Hash{}))
+		k.prepareAccessList(ctx, stateDB, msg, cfg)
+
+		require.True(t, stateDB.AddressInAccessList(msg.From))
+		require.Equal(t, tc.warm, stateDB.AddressInAccessList(cfg.CoinBase), "height %d", tc.height)
+	}
+}
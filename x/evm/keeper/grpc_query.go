@@ -3,10 +3,8 @@ package keeper
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"math/big"
-	"time"
 
 	"github.com/artela-network/artela/x/evm/txs"
 	"github.com/artela-network/artela/x/evm/txs/support"
@@ -25,7 +23,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/ethereum/go-ethereum/core"
 	ethereum "github.com/ethereum/go-ethereum/core/types"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
@@ -38,10 +35,6 @@ import (
 
 var _ txs.QueryServer = Keeper{}
 
-const (
-	defaultTraceTimeout = 5 * time.Second
-)
-
 // Account implements the Query/StateAccount gRPC method
 func (k Keeper) Account(c context.Context, req *txs.QueryAccountRequest) (*txs.QueryAccountResponse, error) {
 	if req == nil {
@@ -206,6 +199,88 @@ func (k Keeper) Code(c context.Context, req *txs.QueryCodeRequest) (*txs.QueryCo
 	}, nil
 }
 
+// CodeSize implements the Query/CodeSize gRPC method
+func (k Keeper) CodeSize(c context.Context, req *txs.QueryCodeSizeRequest) (*txs.QueryCodeSizeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := artela.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+
+	address := common.HexToAddress(req.Address)
+	acct := k.GetAccountWithoutBalance(ctx, address)
+
+	// EOAs and non-existing accounts report the empty code hash and a zero size
+	codeHash := common.BytesToHash(txs.EmptyCodeHash)
+	var codeSize uint64
+	if acct != nil && acct.IsContract() {
+		codeHash = common.BytesToHash(acct.CodeHash)
+		codeSize = uint64(len(k.GetCode(ctx, codeHash)))
+	}
+
+	return &txs.QueryCodeSizeResponse{
+		CodeHash: codeHash.Hex(),
+		CodeSize: codeSize,
+	}, nil
+}
+
+// ActiveForks implements the Query/ActiveForks gRPC method
+func (k Keeper) ActiveForks(c context.Context, req *txs.QueryActiveForksRequest) (*txs.QueryActiveForksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height cannot be negative, got %d", req.Height)
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+
+	height := req.Height
+	if height == 0 {
+		height = ctx.BlockHeight()
+	}
+
+	active, next, nextBlock := k.GetParams(ctx).ChainConfig.ActiveForks(big.NewInt(height))
+	res := &txs.QueryActiveForksResponse{
+		ActiveForks: active,
+		NextFork:    next,
+	}
+	if nextBlock != nil {
+		if !nextBlock.IsInt64() {
+			return nil, status.Errorf(codes.Internal, "fork %s block %s overflows int64", next, nextBlock)
+		}
+		res.NextForkBlock = nextBlock.Int64()
+	}
+	return res, nil
+}
+
+// PendingNonce implements the Query/PendingNonce gRPC method
+func (k Keeper) PendingNonce(c context.Context, req *txs.QueryPendingNonceRequest) (*txs.QueryPendingNonceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := artela.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+
+	return &txs.QueryPendingNonceResponse{
+		Nonce: k.GetPendingNonce(ctx, common.HexToAddress(req.Address)),
+	}, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (k Keeper) Params(c context.Context, _ *txs.QueryParamsRequest) (*txs.QueryParamsResponse, error) {
 	ctx := cosmos.UnwrapSDKContext(c)
@@ -239,10 +314,38 @@ func (k Keeper) EthCall(c context.Context, req *txs.EthCallRequest) (*txs.MsgEth
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	var overrides states.StateOverride
+	if len(req.Overrides) > 0 {
+		if err := json.Unmarshal(req.Overrides, &overrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := overrides.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	var blockOverrides *states.BlockOverrides
+	if len(req.BlockOverrides) > 0 {
+		if err := json.Unmarshal(req.BlockOverrides, &blockOverrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := blockOverrides.Validate(ctx.BlockHeight()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	cfg.BlockOverrides = blockOverrides
+	// the overrides are applied to the scratch StateDB of the call, which is never committed
+	if len(overrides) > 0 {
+		cfg.OnNewEVM = func(evm *vm.EVM) {
+			if stateDB, ok := evm.StateDB.(*states.StateDB); ok {
+				overrides.Apply(stateDB)
+			}
+		}
+	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
@@ -292,12 +395,7 @@ func (k Keeper) EstimateGas(c context.Context, req *txs.EthCallRequest) (*txs.Es
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// Binary search the gas requirement, as it may be higher than the amount used
-	var (
-		lo     = ethparams.TxGas - 1
-		hi     uint64
-		gasCap uint64
-	)
+	var hi uint64
 
 	// Determine the highest gas limit can be used during the estimation.
 	if args.Gas != nil && uint64(*args.Gas) >= ethparams.TxGas {
@@ -318,7 +416,6 @@ func (k Keeper) EstimateGas(c context.Context, req *txs.EthCallRequest) (*txs.Es
 	}
 	txMsg := args.ToTransaction()
 
-	gasCap = hi
 	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to load evm config")
@@ -343,48 +440,12 @@ func (k Keeper) EstimateGas(c context.Context, req *txs.EthCallRequest) (*txs.Es
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	// NOTE: the errors from the executable below should be consistent with go-ethereum,
+	// NOTE: the errors from the estimation should be consistent with go-ethereum,
 	// so we don't wrap them with the gRPC status code
-
-	// Create a helper to check if a gas allowance results in an executable txs
-	executable := func(gas uint64) (vmError bool, rsp *txs.MsgEthereumTxResponse, err error) {
-		// update the message with the new gas value
-		msg.GasLimit = gas
-		// pass false to not commit StateDB
-		rsp, err = k.ApplyMessageWithConfig(ctx, aspectCtx, msg, nil, false, cfg, txConfig)
-		if err != nil {
-			if errors.Is(err, core.ErrIntrinsicGas) {
-				return true, nil, nil // Special case, raise gas limit
-			}
-			return true, nil, err // Bail out
-		}
-		return len(rsp.VmError) > 0, rsp, nil
-	}
-
-	// Execute the binary search and hone in on an executable gas limit
-	hi, err = txs.BinSearch(lo, hi, executable)
+	hi, err = k.EstimateGasWithConfig(ctx, aspectCtx, msg, cfg, txConfig, hi, false)
 	if err != nil {
 		return nil, err
 	}
-
-	// Reject the txs as invalid if it still fails at the highest allowance
-	if hi == gasCap {
-		failed, result, err := executable(hi)
-		if err != nil {
-			return nil, err
-		}
-
-		if failed {
-			if result != nil && result.VmError != vm.ErrOutOfGas.Error() {
-				if result.VmError == vm.ErrExecutionReverted.Error() {
-					return nil, types.NewExecErrorWithReason(result.Ret)
-				}
-				return nil, errors.New(result.VmError)
-			}
-			// Otherwise, the specified gas cap is too low
-			return nil, fmt.Errorf("gas required exceeds allowance (%d)", gasCap)
-		}
-	}
 	return &txs.EstimateGasResponse{Gas: hi}, nil
 }
 
@@ -396,8 +457,10 @@ func (k Keeper) TraceTx(c context.Context, req *txs.QueryTraceTxRequest) (*txs.Q
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	if req.TraceConfig != nil {
+		if err := req.TraceConfig.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 	}
 
 	// minus one to get the context of block beginning
@@ -440,6 +503,7 @@ func (k Keeper) TraceTx(c context.Context, req *txs.QueryTraceTxRequest) (*txs.Q
 		txConfig.TxIndex = uint(i)
 
 		rsp, err := k.ApplyMessageWithConfig(ctx, aspectCtx, msg, txs.NewNoOpTracer(), true, cfg, txConfig)
+		k.advanceTracedNonce(ctx, signer, ethTx)
 		if err != nil {
 			continue
 		}
@@ -453,13 +517,7 @@ func (k Keeper) TraceTx(c context.Context, req *txs.QueryTraceTxRequest) (*txs.Q
 		txConfig.TxIndex++
 	}
 
-	var tracerConfig json.RawMessage
-	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
-		// ignore error. default to no traceConfig
-		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
-	}
-
-	result, _, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, false, tracerConfig)
+	result, _, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, false)
 	if err != nil {
 		// error will be returned with detail status from traceTx
 		return nil, err
@@ -483,8 +541,10 @@ func (k Keeper) TraceBlock(c context.Context, req *txs.QueryTraceBlockRequest) (
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	if req.TraceConfig != nil {
+		if err := req.TraceConfig.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 	}
 
 	// minus one to get the context of block beginning
@@ -508,16 +568,44 @@ func (k Keeper) TraceBlock(c context.Context, req *txs.QueryTraceBlockRequest) (
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
 	signer := ethereum.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix()))
-	txsLength := len(req.Txs)
-	results := make([]*txs.TxTraceResult, 0, txsLength)
 
-	txConfig := states.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
+	blockTxs := make([]*ethereum.Transaction, len(req.Txs))
 	for i, tx := range req.Txs {
+		blockTxs[i] = tx.AsTransaction()
+	}
+	results := k.TraceTxs(ctx, cfg, signer, blockTxs, req.TraceConfig)
+
+	resultData, err := json.Marshal(results)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &txs.QueryTraceBlockResponse{
+		Data: resultData,
+	}, nil
+}
+
+// TraceTxs traces the given block transactions in order on top of a single accumulated states:
+// the states of the block is derived once, and each txs is traced against the states left by
+// its predecessors, using the same TraceConfig. The results are returned in the order of the
+// input transactions, a failed trace is reported in the Error field of its result.
+func (k *Keeper) TraceTxs(
+	ctx cosmos.Context,
+	cfg *states.EVMConfig,
+	signer ethereum.Signer,
+	blockTxs []*ethereum.Transaction,
+	traceConfig *support.TraceConfig,
+) []*txs.TxTraceResult {
+	// all the txs are applied to the same cache context, which is discarded after tracing
+	ctx, _ = ctx.CacheContext()
+
+	results := make([]*txs.TxTraceResult, 0, len(blockTxs))
+	txConfig := states.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
+	for i, tx := range blockTxs {
 		result := txs.TxTraceResult{}
-		ethTx := tx.AsTransaction()
-		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxHash = tx.Hash()
 		txConfig.TxIndex = uint(i)
-		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, nil)
+		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, tx, traceConfig, true)
 		if err != nil {
 			result.Error = err.Error()
 		} else {
@@ -525,16 +613,33 @@ func (k Keeper) TraceBlock(c context.Context, req *txs.QueryTraceBlockRequest) (
 			result.Result = traceResult
 		}
 		results = append(results, &result)
+
+		k.advanceTracedNonce(ctx, signer, tx)
 	}
 
-	resultData, err := json.Marshal(results)
+	return results
+}
+
+// advanceTracedNonce increases the sender nonce of a traced txs. The ante handler doesn't run
+// while tracing, so the nonce of a call needs to be advanced here no matter the execution result,
+// otherwise the following txs (e.g. contract creations) would observe a stale nonce.
+func (k *Keeper) advanceTracedNonce(ctx cosmos.Context, signer ethereum.Signer, tx *ethereum.Transaction) {
+	from, err := ethereum.Sender(signer, tx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return
 	}
 
-	return &txs.QueryTraceBlockResponse{
-		Data: resultData,
-	}, nil
+	acct := k.accountKeeper.GetAccount(ctx, cosmos.AccAddress(from.Bytes()))
+	if acct == nil || acct.GetSequence() > tx.Nonce() {
+		// contract creations have already advanced the nonce during execution
+		return
+	}
+
+	if err := acct.SetSequence(tx.Nonce() + 1); err != nil {
+		k.Logger(ctx).Error("failed to advance nonce of traced txs", "txhash", tx.Hash().String(), "error", err)
+		return
+	}
+	k.accountKeeper.SetAccount(ctx, acct)
 }
 
 // traceTx do trace on one txs, it returns a tuple: (traceResult, nextLogIndex, error).
@@ -546,16 +651,7 @@ func (k *Keeper) traceTx(
 	tx *ethereum.Transaction,
 	traceConfig *support.TraceConfig,
 	commitMessage bool,
-	tracerJSONConfig json.RawMessage,
 ) (*interface{}, uint, error) {
-	// Assemble the structured logger or the JavaScript tracer
-	var (
-		tracer    tracers.Tracer
-		overrides *ethparams.ChainConfig
-		err       error
-		timeout   = defaultTraceTimeout
-	)
-
 	// Aspect Runtime Context Lifecycle: create aspect context.
 	// This marks the beginning of running an aspect of TraceBlock or TraceTx, creating the aspect context,
 	// and establishing the link with the SDK context.
@@ -574,25 +670,7 @@ func (k *Keeper) traceTx(
 		return nil, 0, status.Error(codes.Internal, err.Error())
 	}
 
-	if traceConfig == nil {
-		traceConfig = &support.TraceConfig{}
-	}
-
-	if traceConfig.Overrides != nil {
-		overrides = traceConfig.Overrides.EthereumConfig(cfg.ChainConfig.ChainID)
-	}
-
-	logConfig := logger.Config{
-		EnableMemory:     traceConfig.EnableMemory,
-		DisableStorage:   traceConfig.DisableStorage,
-		DisableStack:     traceConfig.DisableStack,
-		EnableReturnData: traceConfig.EnableReturnData,
-		Debug:            traceConfig.Debug,
-		Limit:            int(traceConfig.Limit),
-		Overrides:        overrides,
-	}
-
-	tracer = logger.NewStructLogger(&logConfig)
+	traceConfig = traceConfig.WithDefaults()
 
 	tCtx := &tracers.Context{
 		BlockHash: txConfig.BlockHash,
@@ -600,31 +678,31 @@ func (k *Keeper) traceTx(
 		TxHash:    txConfig.TxHash,
 	}
 
-	if traceConfig.Tracer != "" {
-		if tracer, err = tracers.DefaultDirectory.New(traceConfig.Tracer, tCtx, tracerJSONConfig); err != nil {
-			return nil, 0, status.Error(codes.Internal, err.Error())
-		}
+	// Assemble the structured logger or the JavaScript tracer
+	tracer, err := txs.ResolveTracer(traceConfig, tCtx, cfg.ChainConfig.ChainID)
+	if err != nil {
+		return nil, 0, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	// Define a meaningful timeout of a single txs trace
-	if traceConfig.Timeout != "" {
-		if timeout, err = time.ParseDuration(traceConfig.Timeout); err != nil {
-			return nil, 0, status.Errorf(codes.InvalidArgument, "timeout value: %s", err.Error())
-		}
+	timeout, err := traceConfig.TimeoutDuration()
+	if err != nil {
+		return nil, 0, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// Handle timeouts and RPC cancellations
-	deadlineCtx, cancel := context.WithTimeout(ctx.Context(), timeout)
-	defer cancel()
+	// Handle timeouts and RPC cancellations, both stop the tracer and abort the interpreter
+	interrupter := txs.NewTraceInterrupter(ctx.Context(), timeout, tracer)
+	defer interrupter.Release()
 
-	go func() {
-		<-deadlineCtx.Done()
-		if errors.Is(deadlineCtx.Err(), context.DeadlineExceeded) {
-			tracer.Stop(errors.New("execution timeout"))
-		}
-	}()
+	traceCfg := *cfg
+	traceCfg.OnNewEVM = interrupter.SetEVM
 
-	res, err := k.ApplyMessageWithConfig(ctx, aspectCtx, msg, tracer, commitMessage, cfg, txConfig)
+	res, err := k.ApplyMessageWithConfig(ctx, aspectCtx, msg, tracer, commitMessage, &traceCfg, txConfig)
+	if err := interrupter.Err(); err != nil {
+		// the output of an aborted trace is partial, discard it along with the states changes
+		commitMessage = false
+		return nil, 0, status.Error(codes.DeadlineExceeded, err.Error())
+	}
 	if err != nil {
 		return nil, 0, status.Error(codes.Internal, err.Error())
 	}
@@ -634,6 +712,11 @@ func (k *Keeper) traceTx(
 		return nil, 0, status.Error(codes.Internal, err.Error())
 	}
 
+	// replace the default struct logger output with the one reporting gas cost and refund per step
+	if structLogger, ok := tracer.(*logger.StructLogger); ok {
+		result = txs.NewExecutionResult(res, structLogger.StructLogs(), traceConfig)
+	}
+
 	return &result, txConfig.LogIndex + uint(len(res.Logs)), nil
 }
 
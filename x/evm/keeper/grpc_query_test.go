@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+func TestQueryActiveForks(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey(types.TransientKey)).WithBlockHeight(10)
+	k := Keeper{storeKey: storeKey, cdc: codec.NewProtoCodec(codectypes.NewInterfaceRegistry())}
+
+	// cancun is scheduled at block 100
+	params := support.DefaultParams()
+	cancunBlock := sdkmath.NewInt(100)
+	params.ChainConfig.CancunBlock = &cancunBlock
+	require.NoError(t, k.SetParams(ctx, params))
+
+	res, err := k.ActiveForks(ctx, &txs.QueryActiveForksRequest{Height: 99})
+	require.NoError(t, err)
+	require.Contains(t, res.ActiveForks, "shanghai")
+	require.NotContains(t, res.ActiveForks, "cancun")
+	require.Equal(t, "cancun", res.NextFork)
+	require.Equal(t, int64(100), res.NextForkBlock)
+
+	// the current height is used by default
+	current, err := k.ActiveForks(ctx, &txs.QueryActiveForksRequest{})
+	require.NoError(t, err)
+	require.Equal(t, res, current)
+
+	res, err = k.ActiveForks(ctx, &txs.QueryActiveForksRequest{Height: 100})
+	require.NoError(t, err)
+	require.Equal(t, "cancun", res.ActiveForks[len(res.ActiveForks)-1])
+	require.Empty(t, res.NextFork)
+	require.Zero(t, res.NextForkBlock)
+
+	_, err = k.ActiveForks(ctx, &txs.QueryActiveForksRequest{Height: -1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
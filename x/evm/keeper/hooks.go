@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
+)
+
+// EvmHooks are the hooks called by the EVM keeper after each successful EVM transaction,
+// e.g. to emit metrics without parsing the events. The block context is available from the
+// context.
+type EvmHooks interface {
+	// PostTxProcessing is called once the states of the txs is committed to the context,
+	// returning an error rolls back the txs, which is then marked as failed.
+	PostTxProcessing(ctx cosmos.Context, msg *txs.MsgEthereumTx, result *support.TxResult) error
+}
+
+var _ EvmHooks = MultiEvmHooks{}
+
+// MultiEvmHooks combines multiple EVM hooks, they are called in registration order.
+type MultiEvmHooks []EvmHooks
+
+// NewMultiEvmHooks combines the given EVM hooks.
+func NewMultiEvmHooks(hooks ...EvmHooks) MultiEvmHooks {
+	return hooks
+}
+
+// PostTxProcessing calls the PostTxProcessing hooks in order, stopping at the first error.
+func (mh MultiEvmHooks) PostTxProcessing(ctx cosmos.Context, msg *txs.MsgEthereumTx, result *support.TxResult) error {
+	for i := range mh {
+		if err := mh[i].PostTxProcessing(ctx, msg, result); err != nil {
+			return errorsmod.Wrapf(err, "EVM hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
+
+// SetHooks sets the EVM hooks of the keeper, it must be called once when wiring the app.
+func (k *Keeper) SetHooks(eh EvmHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set evm hooks twice")
+	}
+
+	k.hooks = eh
+	return k
+}
+
+// PostTxProcessing calls the EVM hooks, if any.
+func (k *Keeper) PostTxProcessing(ctx cosmos.Context, msg *txs.MsgEthereumTx, result *support.TxResult) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.PostTxProcessing(ctx, msg, result)
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+// countingHook counts its invocations and records the order of the hooks.
+type countingHook struct {
+	name  string
+	calls *[]string
+}
+
+func (h countingHook) PostTxProcessing(cosmos.Context, *txs.MsgEthereumTx, *support.TxResult) error {
+	*h.calls = append(*h.calls, h.name)
+	return nil
+}
+
+// revertingHook fails every txs.
+type revertingHook struct{}
+
+func (revertingHook) PostTxProcessing(cosmos.Context, *txs.MsgEthereumTx, *support.TxResult) error {
+	return errors.New("forced revert")
+}
+
+func TestPostTxProcessingHooks(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey(types.TransientKey))
+	msg := &txs.MsgEthereumTx{}
+	result := &support.TxResult{GasUsed: 21000}
+
+	// no hooks
+	k := &Keeper{storeKey: storeKey}
+	require.NoError(t, k.PostTxProcessing(ctx, msg, result))
+
+	var calls []string
+	k.SetHooks(NewMultiEvmHooks(countingHook{"first", &calls}, countingHook{"second", &calls}))
+	require.NoError(t, k.PostTxProcessing(ctx, msg, result))
+	require.NoError(t, k.PostTxProcessing(ctx, msg, result))
+	require.Equal(t, []string{"first", "second", "first", "second"}, calls)
+	require.Panics(t, func() { k.SetHooks(NewMultiEvmHooks()) })
+
+	// a failing hook stops the later ones
+	calls = nil
+	k = &Keeper{storeKey: storeKey}
+	k.SetHooks(NewMultiEvmHooks(countingHook{"first", &calls}, revertingHook{}, countingHook{"third", &calls}))
+	err := k.PostTxProcessing(ctx, msg, result)
+	require.ErrorContains(t, err, "forced revert")
+	require.Equal(t, []string{"first"}, calls)
+}
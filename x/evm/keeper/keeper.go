@@ -25,6 +25,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	paramsmodule "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -84,6 +85,18 @@ type Keeper struct {
 
 	// store the block context, this will be fresh every block.
 	BlockContext *artvmtype.EthBlockContext
+
+	// bloom bits index of the block blooms, used to speed up log queries over block ranges
+	bloomIndexer *BloomIndexer
+
+	// custom precompiled contracts, consulted before the standard precompiles
+	precompiles *states.PrecompileRegistry
+
+	// hooks called after each successful EVM transaction
+	hooks EvmHooks
+
+	// application mempool, consulted for the pending nonces of the accounts
+	mempool mempool.Mempool
 }
 
 // NewKeeper generates new evm module keeper
@@ -131,6 +144,8 @@ func NewKeeper(
 		ss:                   subSpace,
 		aspectRuntimeContext: aspectRuntimeContext,
 		aspect:               aspect,
+		bloomIndexer:         NewBloomIndexer(types.DefaultBloomSectionSize),
+		precompiles:          states.NewPrecompileRegistry(),
 	}
 	k.WithChainID(app.ChainId())
 
@@ -196,6 +211,19 @@ func (k Keeper) ChainID() *big.Int {
 	return k.eip155ChainID
 }
 
+// RegisterPrecompile registers a custom precompiled contract, it fails if its address
+// collides with a standard or an already registered precompile. It must be called when
+// wiring the app, before any txs is executed.
+func (k *Keeper) RegisterPrecompile(p states.StatefulPrecompile) error {
+	return k.precompiles.Register(p)
+}
+
+// ActivePrecompiles returns the addresses of the standard precompiles active under the given
+// rules and of the custom precompiles.
+func (k Keeper) ActivePrecompiles(rules params.Rules) []common.Address {
+	return k.precompiles.ActivePrecompiles(rules)
+}
+
 // GetAuthority returns the x/evm module authority address
 func (k Keeper) GetAuthority() cosmos.AccAddress {
 	return k.authority
@@ -242,6 +270,22 @@ func (k Keeper) SetBlockBloomTransient(ctx cosmos.Context, bloom *big.Int) {
 	)
 }
 
+// IndexBlockBloom adds the bloom of the current block to the bloom bits index.
+func (k Keeper) IndexBlockBloom(ctx cosmos.Context, bloom ethereum.Bloom) error {
+	return k.bloomIndexer.AddBloom(ctx.KVStore(k.storeKey), uint64(ctx.BlockHeight()), bloom)
+}
+
+// BloomCandidates returns the heights within [from, to] of the blocks that may contain logs
+// matching the given addresses and topics, according to the bloom bits index.
+func (k Keeper) BloomCandidates(
+	ctx cosmos.Context,
+	from, to uint64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+) ([]uint64, error) {
+	return k.bloomIndexer.Candidates(ctx.KVStore(k.storeKey), from, to, addresses, topics)
+}
+
 // ----------------------------------------------------------------------------
 // 								  Tx Index
 // ----------------------------------------------------------------------------
@@ -313,6 +357,91 @@ func (k Keeper) GetAccountStorage(ctx cosmos.Context, address common.Address) su
 	return storage
 }
 
+// SetAccountStorage sets the storage states of the account in bulk through a single prefix store,
+// deleting the slots set to the zero word. All the states are validated before any write.
+func (k Keeper) SetAccountStorage(ctx cosmos.Context, address common.Address, states []support.State) error {
+	if err := support.ValidateStates(states); err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(address))
+	for _, state := range states {
+		key := common.HexToHash(state.Key)
+		value := common.HexToHash(state.Value)
+		if value == (common.Hash{}) {
+			store.Delete(key.Bytes())
+		} else {
+			store.Set(key.Bytes(), value.Bytes())
+		}
+	}
+
+	k.Logger(ctx).Debug(
+		"setState: SetAccountStorage",
+		"ethereum-address", address.Hex(),
+		"states", len(states),
+	)
+	return nil
+}
+
+// StorageRange returns up to limit storage states of the account, iterating the account storage
+// in the store order from startKey (inclusive, it doesn't need to be an existing slot). The key to
+// continue the iteration from is returned along the states, and is nil once the storage is exhausted.
+func (k Keeper) StorageRange(ctx cosmos.Context, address common.Address, startKey []byte, limit int) (support.Storage, []byte, error) {
+	if limit <= 0 {
+		return nil, nil, fmt.Errorf("invalid storage range limit %d", limit)
+	}
+	if len(startKey) > common.HashLength {
+		return nil, nil, fmt.Errorf("invalid storage range start key length %d", len(startKey))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(address))
+	iterator := store.Iterator(startKey, nil)
+	defer iterator.Close()
+
+	storage := support.Storage{}
+	for ; iterator.Valid(); iterator.Next() {
+		if len(storage) == limit {
+			return storage, common.CopyBytes(iterator.Key()), nil
+		}
+		storage = append(storage, support.NewState(common.BytesToHash(iterator.Key()), common.BytesToHash(iterator.Value())))
+	}
+
+	return storage, nil, nil
+}
+
+// AccountDump is the canonical dump of the EVM state of an account.
+type AccountDump struct {
+	Address  common.Address  `json:"address"`
+	Balance  *big.Int        `json:"balance"`
+	Nonce    uint64          `json:"nonce"`
+	CodeHash common.Hash     `json:"codeHash"`
+	Storage  support.Storage `json:"storage"`
+}
+
+// DumpAccount returns the dump of the account balance, nonce, code hash and storage, with the
+// storage states sorted by key so that every node produces the same dump for the same height.
+// Non-existing accounts are dumped as empty accounts.
+//
+// NOTE: the whole account storage is loaded in memory, use ForEachStorage to stream the
+// storage of large contracts instead.
+func (k Keeper) DumpAccount(ctx cosmos.Context, address common.Address) *AccountDump {
+	acct := k.GetAccount(ctx, address)
+	if acct == nil {
+		acct = states.NewEmptyAccount()
+	}
+
+	storage := k.GetAccountStorage(ctx, address)
+	support.SortStates(storage)
+
+	return &AccountDump{
+		Address:  address,
+		Balance:  acct.Balance,
+		Nonce:    acct.Nonce,
+		CodeHash: common.BytesToHash(acct.CodeHash),
+		Storage:  storage,
+	}
+}
+
 // ----------------------------------------------------------------------------
 //									Account
 // ----------------------------------------------------------------------------
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+func TestSetAccountStorage(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey(types.TransientKey))
+	k := Keeper{storeKey: storeKey}
+	addr := common.HexToAddress("0x1")
+
+	states := []support.State{
+		support.NewState(common.HexToHash("0x10"), common.HexToHash("0x1")),
+		support.NewState(common.HexToHash("0x0a"), common.HexToHash("0x2")),
+		support.NewState(common.HexToHash("0x03"), common.HexToHash("0x3")),
+	}
+	require.NoError(t, k.SetAccountStorage(ctx, addr, states))
+
+	// read back sorted by key
+	require.Equal(t, support.Storage{states[2], states[1], states[0]}, k.GetAccountStorage(ctx, addr))
+
+	// zero words delete their slot
+	require.NoError(t, k.SetAccountStorage(ctx, addr, []support.State{
+		support.NewState(common.HexToHash("0x0a"), common.Hash{}),
+	}))
+	require.Equal(t, support.Storage{states[2], states[0]}, k.GetAccountStorage(ctx, addr))
+
+	// an invalid state aborts the whole batch
+	err := k.SetAccountStorage(ctx, addr, []support.State{
+		support.NewState(common.HexToHash("0x20"), common.HexToHash("0x4")),
+		{Key: "0x1", Value: common.HexToHash("0x5").String()},
+	})
+	require.Error(t, err)
+	require.Equal(t, support.Storage{states[2], states[0]}, k.GetAccountStorage(ctx, addr))
+
+	// other accounts are untouched
+	require.Empty(t, k.GetAccountStorage(ctx, common.HexToAddress("0x2")))
+}
+
+func TestIterateContracts(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey(types.TransientKey))
+	k := Keeper{storeKey: storeKey}
+
+	contracts := map[common.Address]common.Hash{
+		common.HexToAddress("0x30"): crypto.Keccak256Hash([]byte{0x3}),
+		common.HexToAddress("0x10"): crypto.Keccak256Hash([]byte{0x1}),
+		common.HexToAddress("0x20"): crypto.Keccak256Hash([]byte{0x2}),
+	}
+	for addr, codeHash := range contracts {
+		k.SetContractCodeHash(ctx, addr, codeHash)
+	}
+	// accounts without code are not indexed
+	k.SetContractCodeHash(ctx, common.HexToAddress("0x40"), common.BytesToHash(txs.EmptyCodeHash))
+
+	iterate := func() []common.Address {
+		var addrs []common.Address
+		k.IterateContracts(ctx, func(addr common.Address, codeHash common.Hash) bool {
+			require.Equal(t, contracts[addr], codeHash)
+			addrs = append(addrs, addr)
+			return false
+		})
+		return addrs
+	}
+
+	// the iteration is in address order, whatever the deployment order
+	expected := []common.Address{common.HexToAddress("0x10"), common.HexToAddress("0x20"), common.HexToAddress("0x30")}
+	require.Equal(t, expected, iterate())
+	require.Equal(t, expected, iterate())
+
+	// a destructed contract is dropped
+	k.SetContractCodeHash(ctx, common.HexToAddress("0x20"), common.Hash{})
+	require.Equal(t, []common.Address{common.HexToAddress("0x10"), common.HexToAddress("0x30")}, iterate())
+
+	// the iteration stops early
+	var count int
+	k.IterateContracts(ctx, func(common.Address, common.Hash) bool {
+		count++
+		return true
+	})
+	require.Equal(t, 1, count)
+}
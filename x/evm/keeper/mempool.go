@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+// SetMempool sets the application mempool consulted for the pending nonces of the accounts.
+func (k *Keeper) SetMempool(mp mempool.Mempool) {
+	k.mempool = mp
+}
+
+// GetPendingNonce returns the next usable nonce of the account, including the transactions
+// of the account queued in the application mempool. Like in Ethereum, the queued transactions
+// are only counted up to the first nonce gap.
+func (k *Keeper) GetPendingNonce(ctx cosmos.Context, addr common.Address) uint64 {
+	return k.pendingNonce(ctx, addr, k.GetNonce(ctx, addr))
+}
+
+// pendingNonce increments the given committed nonce for each consecutive nonce of the
+// account queued in the mempool.
+func (k *Keeper) pendingNonce(ctx cosmos.Context, addr common.Address, nonce uint64) uint64 {
+	if k.mempool == nil {
+		return nonce
+	}
+
+	queued := make(map[uint64]struct{})
+	for it := k.mempool.Select(ctx, nil); it != nil; it = it.Next() {
+		for _, msg := range it.Tx().GetMsgs() {
+			ethMsg, ok := msg.(*txs.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+
+			sender, err := ethMsg.GetSender(k.ChainID())
+			if err != nil || sender != addr {
+				continue
+			}
+
+			txData, err := txs.UnpackTxData(ethMsg.Data)
+			if err != nil {
+				continue
+			}
+			queued[txData.GetNonce()] = struct{}{}
+		}
+	}
+
+	for {
+		if _, ok := queued[nonce]; !ok {
+			return nonce
+		}
+		nonce++
+	}
+}
@@ -26,7 +26,8 @@ func (k *Keeper) GetEthIntrinsicGas(ctx cosmos.Context, msg *core.Message, cfg *
 	istanbul := cfg.IsIstanbul(blockHeight)
 
 	// EIP3860(limit and meter initcode): https://eips.ethereum.org/EIPS/eip-3860
-	return core.IntrinsicGas(msg.Data, msg.AccessList, isContractCreation, homestead, istanbul, false)
+	shanghai := k.GetParams(ctx).IsEIPActivated(3860, blockHeight)
+	return core.IntrinsicGas(msg.Data, msg.AccessList, isContractCreation, homestead, istanbul, shanghai)
 }
 
 // RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
@@ -134,7 +135,7 @@ func VerifyFee(
 	txData txs.TxData,
 	denom string,
 	baseFee *big.Int,
-	homestead, istanbul, isCheckTx bool,
+	homestead, istanbul, shanghai, isCheckTx bool,
 ) (cosmos.Coins, error) {
 	gasLimit := txData.GetGas()
 	isContractCreation := txData.GetTo() == nil
@@ -144,12 +145,12 @@ func VerifyFee(
 		accessList = txData.GetAccessList()
 	}
 
-	intrinsicGas, err := core.IntrinsicGas(txData.GetData(), accessList, isContractCreation, homestead, istanbul, false)
+	intrinsicGas, err := core.IntrinsicGas(txData.GetData(), accessList, isContractCreation, homestead, istanbul, shanghai)
 	if err != nil {
 		return nil, errorsmod.Wrapf(
 			err,
-			"failed to retrieve intrinsic gas, contract creation = %t; homestead = %t, istanbul = %t",
-			isContractCreation, homestead, istanbul,
+			"failed to retrieve intrinsic gas, contract creation = %t; homestead = %t, istanbul = %t, shanghai = %t",
+			isContractCreation, homestead, istanbul, shanghai,
 		)
 	}
 
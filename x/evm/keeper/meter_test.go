@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasToRefundEIP3529(t *testing.T) {
+	// clearing two storage slots accrues two SSTORE clear refunds
+	clearedSlots := uint64(2)
+	refundCounter := clearedSlots * params.SstoreClearsScheduleRefundEIP3529
+
+	// the refund is below the cap of 1/5 of the consumed gas
+	refund := GasToRefund(refundCounter, 100_000, params.RefundQuotientEIP3529)
+	require.Equal(t, refundCounter, refund)
+	require.NotZero(t, refund)
+
+	// the refund is capped at 1/5 of the consumed gas
+	refund = GasToRefund(refundCounter, 30_000, params.RefundQuotientEIP3529)
+	require.Equal(t, uint64(6_000), refund)
+
+	// pre-london the cap is 1/2 of the consumed gas
+	refund = GasToRefund(refundCounter, 30_000, params.RefundQuotient)
+	require.Equal(t, refundCounter, refund)
+}
@@ -7,6 +7,11 @@ import (
 )
 
 // Migrator is a struct for handling in-place store migrations.
+//
+// NOTE: the txs results (TxResult) are not persisted in the evm module store, they only exist in
+// the block results of the consensus engine, and migrations only have access to the latest states.
+// Historical results, e.g. with a zero GasUsed, can't be backfilled by a store migration and have
+// to be recomputed off-chain by replaying the txs against an archive node.
 type Migrator struct {
 	keeper Keeper
 }
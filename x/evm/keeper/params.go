@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"fmt"
+
+	artela "github.com/artela-network/artela/ethereum/types"
 	"github.com/artela-network/artela/x/evm/txs/support"
 	"github.com/artela-network/artela/x/evm/types"
 	cosmos "github.com/cosmos/cosmos-sdk/types"
@@ -32,6 +34,9 @@ func (k Keeper) SetParams(ctx cosmos.Context, params support.Params) error {
 	if err := params.Validate(); err != nil {
 		return err
 	}
+	if err := params.ValidateBlockGasLimit(artela.BlockGasLimit(ctx)); err != nil {
+		return err
+	}
 
 	store := ctx.KVStore(k.storeKey)
 	bz, err := k.cdc.Marshal(&params)
@@ -46,6 +51,12 @@ func (k Keeper) SetParams(ctx cosmos.Context, params support.Params) error {
 	return nil
 }
 
+// BlockGasLimit returns the block gas limit used by the EVM, the BlockGasLimit params override
+// if set, the consensus block gas limit otherwise.
+func (k Keeper) BlockGasLimit(ctx cosmos.Context) uint64 {
+	return k.GetParams(ctx).EffectiveBlockGasLimit(artela.BlockGasLimit(ctx))
+}
+
 // GetLegacyParams returns param set for version before migrate
 func (k Keeper) GetLegacyParams(ctx cosmos.Context) support.Params {
 	var params support.Params
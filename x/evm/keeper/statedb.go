@@ -11,6 +11,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/artela-network/artela/x/evm/states"
+	"github.com/artela-network/artela/x/evm/txs"
 	"github.com/artela-network/artela/x/evm/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	cosmos "github.com/cosmos/cosmos-sdk/types"
@@ -36,9 +37,9 @@ func (k *Keeper) GetAccount(ctx cosmos.Context, addr common.Address) *states.Sta
 
 // GetState loads contract states from database, implements `states.Keeper` interface.
 func (k *Keeper) GetState(ctx cosmos.Context, addr common.Address, key common.Hash) common.Hash {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
+	store := ctx.KVStore(k.storeKey)
 
-	value := store.Get(key.Bytes())
+	value := store.Get(types.StorageKey(addr, key))
 	if len(value) == 0 {
 		return common.Hash{}
 	}
@@ -111,6 +112,7 @@ func (k *Keeper) SetAccount(ctx cosmos.Context, addr common.Address, account sta
 	}
 
 	k.accountKeeper.SetAccount(ctx, acct)
+	k.SetContractCodeHash(ctx, addr, codeHash)
 
 	if err := k.SetBalance(ctx, addr, account.Balance); err != nil {
 		return err
@@ -128,13 +130,13 @@ func (k *Keeper) SetAccount(ctx cosmos.Context, addr common.Address, account sta
 
 // SetState update contract storage, delete if value is empty.
 func (k *Keeper) SetState(ctx cosmos.Context, addr common.Address, key common.Hash, value []byte) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
+	store := ctx.KVStore(k.storeKey)
 	action := "updated"
 	if len(value) == 0 {
-		store.Delete(key.Bytes())
+		store.Delete(types.StorageKey(addr, key))
 		action = "deleted"
 	} else {
-		store.Set(key.Bytes(), value)
+		store.Set(types.StorageKey(addr, key), value)
 	}
 	k.Logger(ctx).Debug(
 		fmt.Sprintf("setState: SetState %s", action),
@@ -162,6 +164,32 @@ func (k *Keeper) SetCode(ctx cosmos.Context, codeHash, code []byte) {
 	)
 }
 
+// SetContractCodeHash indexes the code hash of a contract by address, the index entry is
+// deleted if the account has no code.
+func (k *Keeper) SetContractCodeHash(ctx cosmos.Context, addr common.Address, codeHash common.Hash) {
+	store := ctx.KVStore(k.storeKey)
+	if codeHash == (common.Hash{}) || codeHash == common.BytesToHash(txs.EmptyCodeHash) {
+		store.Delete(types.ContractCodeHashKey(addr))
+		return
+	}
+	store.Set(types.ContractCodeHashKey(addr), codeHash.Bytes())
+}
+
+// IterateContracts iterates over the contracts and their code hashes in address order,
+// independently of the auth module, e.g. to export a stable contract list. A contract is
+// indexed once its account is written. The callback returns true to stop the iteration.
+func (k *Keeper) IterateContracts(ctx cosmos.Context, fn func(addr common.Address, codeHash common.Hash) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractCodeHash)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if fn(common.BytesToAddress(iterator.Key()), common.BytesToHash(iterator.Value())) {
+			return
+		}
+	}
+}
+
 // ForEachStorage iterate contract storage, callback return false to break early
 func (k *Keeper) ForEachStorage(ctx cosmos.Context, addr common.Address, cb func(key, value common.Hash) bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -212,6 +240,7 @@ func (k *Keeper) DeleteAccount(ctx cosmos.Context, addr common.Address) error {
 
 	// remove auth account
 	k.accountKeeper.RemoveAccount(ctx, acct)
+	k.SetContractCodeHash(ctx, addr, common.Hash{})
 
 	k.Logger(ctx).Debug(
 		"account suicided",
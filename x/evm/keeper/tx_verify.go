@@ -10,6 +10,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	artelatype "github.com/artela-network/artela/x/evm/artela/types"
 	"github.com/artela-network/artela/x/evm/states"
+	"github.com/artela-network/artela/x/evm/txs"
 	"github.com/artela-network/aspect-core/djpm"
 	cosmos "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
@@ -35,14 +36,12 @@ func (k *Keeper) VerifySig(ctx cosmos.Context, tx *ethereum.Transaction) (common
 	chainCfg := evmParams.GetChainConfig()
 	ethCfg := chainCfg.EthereumConfig(chainID)
 	blockNum := big.NewInt(ctx.BlockHeight())
-	signer := ethereum.MakeSigner(ethCfg, blockNum, uint64(ctx.BlockTime().Unix()))
 
-	allowUnprotectedTxs := evmParams.GetAllowUnprotectedTxs()
-	if !allowUnprotectedTxs && !tx.Protected() {
-		return common.Address{}, nil, errorsmod.Wrapf(
-			errortypes.ErrNotSupported,
-			"rejected unprotected Ethereum transaction. Please EIP155 sign your transaction to protect it against replay-attacks")
+	if err := txs.VerifyReplayProtection(tx, evmParams.GetAllowUnprotectedTxs()); err != nil {
+		return common.Address{}, nil, err
 	}
+
+	signer := txs.TxSigner(tx, ethCfg, blockNum, uint64(ctx.BlockTime().Unix()))
 	sender, err := signer.Sender(tx)
 	if err != nil {
 		return common.Address{}, nil, errorsmod.Wrapf(
@@ -3,6 +3,7 @@ package states
 import (
 	"math/big"
 
+	"github.com/artela-network/artela-evm/vm"
 	"github.com/artela-network/artela/x/evm/txs/support"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
@@ -15,6 +16,12 @@ type EVMConfig struct {
 	ChainConfig *params.ChainConfig
 	CoinBase    common.Address
 	BaseFee     *big.Int
+	// OnNewEVM, if set, is called with the EVM created to apply a message, e.g. to be able
+	// to cancel a traced execution from another goroutine.
+	OnNewEVM func(evm *vm.EVM)
+	// BlockOverrides, if set, overrides the fields of the block context of the EVM, e.g. for
+	// eth_call simulations.
+	BlockOverrides *BlockOverrides
 }
 
 // TxConfig encapulates the readonly information of current txs for `StateDB`.
@@ -0,0 +1,116 @@
+package states
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/artela-network/artela-evm/vm"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+var _ vm.EVMLogger = &OpcodeGuard{}
+
+// OpcodeGuard forbids a set of opcodes in the EVM, e.g. SELFDESTRUCT for a regulated
+// deployment. It is hooked into the interpreter as the EVM tracer, wrapping the actual
+// tracer if any, so it is consulted on each step. Once a blocked opcode is hit the EVM is
+// cancelled, and the execution must be aborted with the error returned by Err.
+type OpcodeGuard struct {
+	tracer  vm.EVMLogger
+	blocked [256]bool
+
+	evm *vm.EVM
+	err error
+}
+
+// NewOpcodeGuard returns an OpcodeGuard blocking the given opcodes and forwarding the
+// execution events to the given tracer, which may be nil.
+func NewOpcodeGuard(blockedOpcodes []int64, tracer vm.EVMLogger) *OpcodeGuard {
+	g := &OpcodeGuard{tracer: tracer}
+	for _, op := range blockedOpcodes {
+		if op >= 0 && op < int64(len(g.blocked)) {
+			g.blocked[op] = true
+		}
+	}
+	return g
+}
+
+// Err returns the invalid opcode error of the blocked opcode hit by the execution, if any.
+func (g *OpcodeGuard) Err() error {
+	return g.err
+}
+
+// Result returns the result of an execution started at the given snapshot of the StateDB.
+// If a blocked opcode was hit, the states changes of the execution are reverted and all the
+// gas is consumed, as for any invalid opcode.
+func (g *OpcodeGuard) Result(stateDB vm.StateDB, snapshot int, ret []byte, leftOverGas uint64, vmErr error) ([]byte, uint64, error) {
+	if g.err == nil {
+		return ret, leftOverGas, vmErr
+	}
+	stateDB.RevertToSnapshot(snapshot)
+	return nil, 0, g.err
+}
+
+// CaptureStart implements vm.EVMLogger interface
+func (g *OpcodeGuard) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	g.evm = env
+	if g.tracer != nil {
+		g.tracer.CaptureStart(env, from, to, create, input, gas, value)
+	}
+}
+
+// CaptureState implements vm.EVMLogger interface, it cancels the EVM on a blocked opcode.
+func (g *OpcodeGuard) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if g.blocked[op] && g.err == nil {
+		g.err = fmt.Errorf("%w: %s is blocked", types.ErrInvalidOpcode, op)
+		if g.evm != nil {
+			g.evm.Cancel()
+		}
+	}
+	if g.tracer != nil {
+		g.tracer.CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+	}
+}
+
+// CaptureFault implements vm.EVMLogger interface
+func (g *OpcodeGuard) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+	if g.tracer != nil {
+		g.tracer.CaptureFault(pc, op, gas, cost, scope, depth, err)
+	}
+}
+
+// CaptureEnd implements vm.EVMLogger interface
+func (g *OpcodeGuard) CaptureEnd(output []byte, gasUsed uint64, err error) {
+	if g.tracer != nil {
+		g.tracer.CaptureEnd(output, gasUsed, err)
+	}
+}
+
+// CaptureEnter implements vm.EVMLogger interface
+func (g *OpcodeGuard) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if g.tracer != nil {
+		g.tracer.CaptureEnter(typ, from, to, input, gas, value)
+	}
+}
+
+// CaptureExit implements vm.EVMLogger interface
+func (g *OpcodeGuard) CaptureExit(output []byte, gasUsed uint64, err error) {
+	if g.tracer != nil {
+		g.tracer.CaptureExit(output, gasUsed, err)
+	}
+}
+
+// CaptureTxStart implements vm.EVMLogger interface
+func (g *OpcodeGuard) CaptureTxStart(gasLimit uint64) {
+	if g.tracer != nil {
+		g.tracer.CaptureTxStart(gasLimit)
+	}
+}
+
+// CaptureTxEnd implements vm.EVMLogger interface
+func (g *OpcodeGuard) CaptureTxEnd(restGas uint64) {
+	if g.tracer != nil {
+		g.tracer.CaptureTxEnd(restGas)
+	}
+}
@@ -0,0 +1,58 @@
+package states
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	artcore "github.com/artela-network/artela-evm/core"
+	"github.com/artela-network/artela-evm/vm"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+func TestOpcodeGuardBlocksSelfdestruct(t *testing.T) {
+	stateDB := New(cosmos.Context{}, emptyKeeper{}, NewEmptyTxConfig(common.Hash{}))
+	caller := common.HexToAddress("0xca11e4")
+	contract := common.HexToAddress("0xc0de")
+	// CALLER SELFDESTRUCT
+	stateDB.SetCode(contract, []byte{byte(vm.CALLER), byte(vm.SELFDESTRUCT)})
+	stateDB.AddBalance(contract, big.NewInt(100))
+
+	guard := NewOpcodeGuard([]int64{int64(vm.SELFDESTRUCT)}, nil)
+	evm := vm.NewEVM(vm.BlockContext{
+		CanTransfer: artcore.CanTransfer,
+		Transfer:    artcore.Transfer,
+		BlockNumber: big.NewInt(0),
+	}, vm.TxContext{}, stateDB, params.TestChainConfig, vm.Config{Tracer: guard})
+
+	snapshot := stateDB.Snapshot()
+	ret, leftOverGas, err := evm.Call(context.Background(), vm.AccountRef(caller), contract, nil, 100_000, big.NewInt(0))
+	ret, leftOverGas, err = guard.Result(stateDB, snapshot, ret, leftOverGas, err)
+	require.ErrorIs(t, err, types.ErrInvalidOpcode)
+	require.ErrorIs(t, guard.Err(), types.ErrInvalidOpcode)
+	require.Nil(t, ret)
+	require.Zero(t, leftOverGas)
+
+	// the contract is not destructed
+	require.False(t, stateDB.HasSuicided(contract))
+	require.Equal(t, big.NewInt(100), stateDB.GetBalance(contract))
+	require.Equal(t, common.Big0, stateDB.GetBalance(caller))
+
+	// other opcodes are executed
+	guard = NewOpcodeGuard([]int64{int64(vm.SELFDESTRUCT)}, nil)
+	stateDB.SetCode(contract, []byte{byte(vm.CALLER), byte(vm.POP), byte(vm.STOP)})
+	evm = vm.NewEVM(vm.BlockContext{
+		CanTransfer: artcore.CanTransfer,
+		Transfer:    artcore.Transfer,
+		BlockNumber: big.NewInt(0),
+	}, vm.TxContext{}, stateDB, params.TestChainConfig, vm.Config{Tracer: guard})
+	_, leftOverGas, err = evm.Call(context.Background(), vm.AccountRef(caller), contract, nil, 100_000, big.NewInt(0))
+	require.NoError(t, err)
+	require.NoError(t, guard.Err())
+	require.NotZero(t, leftOverGas)
+}
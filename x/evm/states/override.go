@@ -0,0 +1,122 @@
+package states
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/artela-network/artela-evm/vm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// OverrideAccount indicates the overriding fields of an account during the execution of a
+// message call, e.g. for eth_call. The hex inputs are validated when decoding the JSON.
+// Note, state and stateDiff can't be specified at the same time. If state is set, the
+// message execution only uses the storage in the given state. Otherwise if stateDiff is set,
+// the diff is merged into the storage of the account.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   **hexutil.Big                `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// StateOverride is the collection of overridden accounts.
+type StateOverride map[common.Address]OverrideAccount
+
+// Validate returns an error if an account sets both state and stateDiff.
+func (diff StateOverride) Validate() error {
+	for addr, account := range diff {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+		if account.Balance != nil && *account.Balance != nil && (*big.Int)(*account.Balance).Sign() < 0 {
+			return fmt.Errorf("account %s has a negative balance", addr.Hex())
+		}
+	}
+	return nil
+}
+
+// Apply overrides the fields of the accounts into the given StateDB. The overrides must have
+// been validated, and the StateDB must not be committed afterwards.
+func (diff StateOverride) Apply(stateDB *StateDB) {
+	for addr, account := range diff {
+		if account.Nonce != nil {
+			stateDB.SetNonce(addr, uint64(*account.Nonce))
+		}
+		if account.Code != nil {
+			stateDB.SetCode(addr, *account.Code)
+		}
+		if account.Balance != nil {
+			balance := new(big.Int)
+			if *account.Balance != nil {
+				balance.Set((*big.Int)(*account.Balance))
+			}
+			stateDB.SetBalance(addr, balance)
+		}
+		// replace the entire storage
+		if account.State != nil {
+			stateDB.SetStorage(addr, *account.State)
+		}
+		// merge the diff into the storage
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				stateDB.SetState(addr, key, value)
+			}
+		}
+	}
+}
+
+// BlockOverrides is a set of header fields to override in the block context of a message
+// call, e.g. to simulate eth_call in a hypothetical future block. The unset fields fall back
+// to the block the call is executed in.
+type BlockOverrides struct {
+	Number     *hexutil.Big
+	Difficulty *hexutil.Big
+	Time       *hexutil.Uint64
+	GasLimit   *hexutil.Uint64
+	Coinbase   *common.Address
+	Random     *common.Hash
+	BaseFee    *hexutil.Big
+}
+
+// Validate returns an error if the overridden block number is below the given current
+// height, the block hashes of the heights in between would not be available to BLOCKHASH.
+func (diff *BlockOverrides) Validate(currentHeight int64) error {
+	if diff == nil || diff.Number == nil {
+		return nil
+	}
+	if number := diff.Number.ToInt(); number.Cmp(big.NewInt(currentHeight)) < 0 {
+		return fmt.Errorf("block number override %s is below the current height %d", number, currentHeight)
+	}
+	return nil
+}
+
+// Apply overrides the given header fields into the given block context.
+func (diff *BlockOverrides) Apply(blockCtx *vm.BlockContext) {
+	if diff == nil {
+		return
+	}
+	if diff.Number != nil {
+		blockCtx.BlockNumber = diff.Number.ToInt()
+	}
+	if diff.Difficulty != nil {
+		blockCtx.Difficulty = diff.Difficulty.ToInt()
+	}
+	if diff.Time != nil {
+		blockCtx.Time = uint64(*diff.Time)
+	}
+	if diff.GasLimit != nil {
+		blockCtx.GasLimit = uint64(*diff.GasLimit)
+	}
+	if diff.Coinbase != nil {
+		blockCtx.Coinbase = *diff.Coinbase
+	}
+	if diff.Random != nil {
+		blockCtx.Random = diff.Random
+	}
+	if diff.BaseFee != nil {
+		blockCtx.BaseFee = diff.BaseFee.ToInt()
+	}
+}
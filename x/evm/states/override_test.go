@@ -0,0 +1,111 @@
+package states
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	artcore "github.com/artela-network/artela-evm/core"
+	"github.com/artela-network/artela-evm/vm"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+// storageKeeper is a Keeper holding the storage of accounts.
+type storageKeeper struct {
+	emptyKeeper
+	storage map[common.Address]Storage
+}
+
+func (k storageKeeper) GetState(_ cosmos.Context, addr common.Address, key common.Hash) common.Hash {
+	return k.storage[addr][key]
+}
+
+func TestStateOverride(t *testing.T) {
+	addr := common.HexToAddress("0xc0de")
+	slot1, slot2 := common.HexToHash("0x1"), common.HexToHash("0x2")
+	keeper := storageKeeper{storage: map[common.Address]Storage{
+		addr: {slot1: common.HexToHash("0x11"), slot2: common.HexToHash("0x22")},
+	}}
+
+	var overrides StateOverride
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"0x000000000000000000000000000000000000c0de": {
+			"balance": "0xde0b6b3a7640000",
+			"nonce": "0x5",
+			"stateDiff": {"0x0000000000000000000000000000000000000000000000000000000000000001": "0x00000000000000000000000000000000000000000000000000000000000000ff"}
+		}
+	}`), &overrides))
+	require.NoError(t, overrides.Validate())
+
+	stateDB := New(cosmos.Context{}, keeper, NewEmptyTxConfig(common.Hash{}))
+	overrides.Apply(stateDB)
+	require.Equal(t, big.NewInt(1e18), stateDB.GetBalance(addr))
+	require.Equal(t, uint64(5), stateDB.GetNonce(addr))
+	// the diff is merged into the storage
+	require.Equal(t, common.HexToHash("0xff"), stateDB.GetState(addr, slot1))
+	require.Equal(t, common.HexToHash("0x22"), stateDB.GetState(addr, slot2))
+
+	// the state replaces the storage
+	overrides = StateOverride{addr: {State: &map[common.Hash]common.Hash{slot1: common.HexToHash("0xaa")}}}
+	require.NoError(t, overrides.Validate())
+	stateDB = New(cosmos.Context{}, keeper, NewEmptyTxConfig(common.Hash{}))
+	overrides.Apply(stateDB)
+	require.Equal(t, common.HexToHash("0xaa"), stateDB.GetState(addr, slot1))
+	require.Equal(t, common.Hash{}, stateDB.GetState(addr, slot2))
+	require.Equal(t, common.Hash{}, stateDB.GetCommittedState(addr, slot2))
+
+	// state and stateDiff are exclusive
+	overrides = StateOverride{addr: {
+		State:     &map[common.Hash]common.Hash{},
+		StateDiff: &map[common.Hash]common.Hash{},
+	}}
+	require.Error(t, overrides.Validate())
+
+	// the hex inputs are validated
+	for _, invalid := range []string{
+		`{"0x000000000000000000000000000000000000c0de": {"balance": "1000"}}`,
+		`{"0x000000000000000000000000000000000000c0de": {"code": "0xzz"}}`,
+		`{"0x000000000000000000000000000000000000c0de": {"stateDiff": {"0x01": "0x02"}}}`,
+		`{"0xc0de": {}}`,
+	} {
+		require.Error(t, json.Unmarshal([]byte(invalid), &overrides), invalid)
+	}
+}
+
+func TestBlockOverrides(t *testing.T) {
+	var overrides *BlockOverrides
+	require.NoError(t, json.Unmarshal([]byte(`{"time": "0x77359400", "number": "0x64"}`), &overrides))
+	require.NoError(t, overrides.Validate(100))
+	// a number below the current height is rejected
+	require.Error(t, overrides.Validate(101))
+
+	blockCtx := vm.BlockContext{
+		CanTransfer: artcore.CanTransfer,
+		Transfer:    artcore.Transfer,
+		BlockNumber: big.NewInt(10),
+		Time:        1_000,
+		GasLimit:    30_000_000,
+	}
+	overrides.Apply(&blockCtx)
+	require.Equal(t, big.NewInt(100), blockCtx.BlockNumber)
+	require.Equal(t, uint64(2_000_000_000), blockCtx.Time)
+	// the unset fields are untouched
+	require.Equal(t, uint64(30_000_000), blockCtx.GasLimit)
+
+	// the contract sees the overridden timestamp
+	stateDB := New(cosmos.Context{}, emptyKeeper{}, NewEmptyTxConfig(common.Hash{}))
+	contract := common.HexToAddress("0xc0de")
+	// TIMESTAMP PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	stateDB.SetCode(contract, []byte{
+		byte(vm.TIMESTAMP), byte(vm.PUSH1), 0, byte(vm.MSTORE),
+		byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.RETURN),
+	})
+	evm := vm.NewEVM(blockCtx, vm.TxContext{}, stateDB, params.TestChainConfig, vm.Config{})
+	ret, _, err := evm.Call(context.Background(), vm.AccountRef(common.HexToAddress("0xca11e4")), contract, nil, 100_000, big.NewInt(0))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2_000_000_000), new(big.Int).SetBytes(ret).Uint64())
+}
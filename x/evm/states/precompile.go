@@ -0,0 +1,152 @@
+package states
+
+import (
+	"math/big"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/artela-network/artela-evm/vm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+// StatefulPrecompile is a chain specific precompiled contract, e.g. a staking precompile.
+// Unlike the standard precompiles it has access to the EVM executing the call, so it can
+// read and write the states through the StateDB of the EVM.
+type StatefulPrecompile interface {
+	// Address returns the address the precompile is deployed at.
+	Address() common.Address
+	// RequiredGas returns the gas needed to run the precompile with the given input.
+	RequiredGas(input []byte) uint64
+	// Run executes the precompile, readonly is set for STATICCALL and nested static calls,
+	// in which case the states must not be modified.
+	Run(evm *vm.EVM, caller common.Address, input []byte, value *big.Int, readonly bool) ([]byte, error)
+}
+
+// standardPrecompiles are the addresses of the precompiles of the latest fork, which also
+// include the ones of all the previous forks.
+var standardPrecompiles = vm.ActivePrecompiles(params.Rules{
+	IsHomestead: true,
+	IsByzantium: true,
+	IsIstanbul:  true,
+	IsBerlin:    true,
+	IsLondon:    true,
+	IsCancun:    true,
+})
+
+// PrecompileRegistry holds the custom precompiles keyed by address. The EVM consults it
+// before falling back to the standard precompiles.
+type PrecompileRegistry struct {
+	precompiles map[common.Address]StatefulPrecompile
+}
+
+// NewPrecompileRegistry returns an empty PrecompileRegistry.
+func NewPrecompileRegistry() *PrecompileRegistry {
+	return &PrecompileRegistry{
+		precompiles: make(map[common.Address]StatefulPrecompile),
+	}
+}
+
+// Register adds a custom precompile to the registry, it fails if the address of the
+// precompile collides with a standard precompile or an already registered one.
+func (r *PrecompileRegistry) Register(p StatefulPrecompile) error {
+	addr := p.Address()
+	if addr == (common.Address{}) {
+		return errorsmod.Wrap(types.ErrInvalidPrecompile, "zero address")
+	}
+	for _, std := range standardPrecompiles {
+		if addr == std {
+			return errorsmod.Wrapf(types.ErrInvalidPrecompile, "address %s collides with a standard precompile", addr)
+		}
+	}
+	if _, found := r.precompiles[addr]; found {
+		return errorsmod.Wrapf(types.ErrInvalidPrecompile, "address %s is already registered", addr)
+	}
+
+	r.precompiles[addr] = p
+	return nil
+}
+
+// Get returns the custom precompile at the given address, if any.
+func (r *PrecompileRegistry) Get(addr common.Address) (StatefulPrecompile, bool) {
+	p, found := r.precompiles[addr]
+	return p, found
+}
+
+// Addresses returns the sorted addresses of the registered precompiles.
+func (r *PrecompileRegistry) Addresses() []common.Address {
+	addrs := make([]common.Address, 0, len(r.precompiles))
+	for addr := range r.precompiles {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return addrs[i].Hex() < addrs[j].Hex()
+	})
+	return addrs
+}
+
+// ActivePrecompiles returns the addresses of the standard precompiles of the given rules
+// followed by the ones of the custom precompiles, e.g. to warm them up in the access list.
+func (r *PrecompileRegistry) ActivePrecompiles(rules params.Rules) []common.Address {
+	return append(vm.ActivePrecompiles(rules), r.Addresses()...)
+}
+
+// Call runs the custom precompile at addr the same way the EVM runs a standard precompile:
+// the value is transferred to the precompile, the required gas is charged and the states
+// changes are reverted if the execution fails. All the gas is consumed on failure, unless
+// the execution is reverted.
+func (r *PrecompileRegistry) Call(
+	evm *vm.EVM,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	gas uint64,
+	value *big.Int,
+	readonly bool,
+) (ret []byte, leftOverGas uint64, err error) {
+	p, found := r.precompiles[addr]
+	if !found {
+		return nil, gas, errorsmod.Wrapf(types.ErrInvalidPrecompile, "no precompile at address %s", addr)
+	}
+
+	if value == nil {
+		value = new(big.Int)
+	}
+	if readonly && value.Sign() != 0 {
+		return nil, gas, vm.ErrWriteProtection
+	}
+	if value.Sign() != 0 && !evm.Context.CanTransfer(evm.StateDB, caller, value) {
+		return nil, gas, vm.ErrInsufficientBalance
+	}
+
+	snapshot := evm.StateDB.Snapshot()
+	evm.Context.Transfer(evm.StateDB, caller, addr, value)
+
+	ret, leftOverGas, err = runPrecompile(evm, p, caller, input, gas, value, readonly)
+	if err != nil {
+		evm.StateDB.RevertToSnapshot(snapshot)
+		if err != vm.ErrExecutionReverted {
+			leftOverGas = 0
+		}
+	}
+	return ret, leftOverGas, err
+}
+
+func runPrecompile(
+	evm *vm.EVM,
+	p StatefulPrecompile,
+	caller common.Address,
+	input []byte,
+	gas uint64,
+	value *big.Int,
+	readonly bool,
+) ([]byte, uint64, error) {
+	gasCost := p.RequiredGas(input)
+	if gas < gasCost {
+		return nil, 0, vm.ErrOutOfGas
+	}
+	ret, err := p.Run(evm, caller, input, value, readonly)
+	return ret, gas - gasCost, err
+}
@@ -0,0 +1,99 @@
+package states
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	artcore "github.com/artela-network/artela-evm/core"
+	"github.com/artela-network/artela-evm/vm"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+// emptyKeeper is a Keeper without any account.
+type emptyKeeper struct{}
+
+func (emptyKeeper) GetAccount(cosmos.Context, common.Address) *StateAccount { return nil }
+func (emptyKeeper) GetState(cosmos.Context, common.Address, common.Hash) common.Hash {
+	return common.Hash{}
+}
+func (emptyKeeper) GetCode(cosmos.Context, common.Hash) []byte { return nil }
+func (emptyKeeper) ForEachStorage(cosmos.Context, common.Address, func(key, value common.Hash) bool) {
+}
+func (emptyKeeper) SetAccount(cosmos.Context, common.Address, StateAccount) error { return nil }
+func (emptyKeeper) SetState(cosmos.Context, common.Address, common.Hash, []byte)  {}
+func (emptyKeeper) SetCode(cosmos.Context, []byte, []byte)                        {}
+func (emptyKeeper) DeleteAccount(cosmos.Context, common.Address) error            { return nil }
+
+// echoPrecompile returns its input, it logs the call unless it is readonly.
+type echoPrecompile struct {
+	addr common.Address
+}
+
+func (p echoPrecompile) Address() common.Address { return p.addr }
+
+func (p echoPrecompile) RequiredGas(input []byte) uint64 { return 100 + uint64(len(input)) }
+
+func (p echoPrecompile) Run(evm *vm.EVM, caller common.Address, input []byte, _ *big.Int, readonly bool) ([]byte, error) {
+	if bytes.Equal(input, []byte("revert")) {
+		return nil, vm.ErrExecutionReverted
+	}
+	if !readonly {
+		evm.StateDB.AddLog(&ethereum.Log{Address: p.addr, Topics: []common.Hash{common.BytesToHash(caller.Bytes())}})
+	}
+	return input, nil
+}
+
+func TestPrecompileRegistry(t *testing.T) {
+	registry := NewPrecompileRegistry()
+	echoAddr := common.HexToAddress("0x0000000000000000000000000000000000000800")
+	require.NoError(t, registry.Register(echoPrecompile{addr: echoAddr}))
+
+	// collisions are rejected
+	err := registry.Register(echoPrecompile{addr: echoAddr})
+	require.ErrorIs(t, err, types.ErrInvalidPrecompile)
+	err = registry.Register(echoPrecompile{addr: common.BytesToAddress([]byte{0x1})})
+	require.ErrorIs(t, err, types.ErrInvalidPrecompile)
+	err = registry.Register(echoPrecompile{})
+	require.ErrorIs(t, err, types.ErrInvalidPrecompile)
+
+	rules := params.TestChainConfig.Rules(big.NewInt(0), false, 0)
+	active := registry.ActivePrecompiles(rules)
+	require.Equal(t, echoAddr, active[len(active)-1])
+	require.Len(t, active, len(vm.ActivePrecompiles(rules))+1)
+
+	stateDB := New(cosmos.Context{}, emptyKeeper{}, NewEmptyTxConfig(common.Hash{}))
+	evm := vm.NewEVM(vm.BlockContext{
+		CanTransfer: artcore.CanTransfer,
+		Transfer:    artcore.Transfer,
+		BlockNumber: big.NewInt(0),
+	}, vm.TxContext{}, stateDB, params.TestChainConfig, vm.Config{})
+	caller := common.HexToAddress("0xca11e4")
+
+	ret, leftOverGas, err := registry.Call(evm, caller, echoAddr, []byte("hello"), 1000, nil, false)
+	require.NoError(t, err)
+	require.Equal(t, []byte("hello"), ret)
+	require.Equal(t, uint64(1000-105), leftOverGas)
+	require.Len(t, stateDB.Logs(), 1)
+
+	// readonly calls can not transfer value
+	_, leftOverGas, err = registry.Call(evm, caller, echoAddr, nil, 1000, big.NewInt(1), true)
+	require.ErrorIs(t, err, vm.ErrWriteProtection)
+	require.Equal(t, uint64(1000), leftOverGas)
+
+	// a revert keeps the leftover gas
+	_, leftOverGas, err = registry.Call(evm, caller, echoAddr, []byte("revert"), 1000, nil, false)
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	require.Equal(t, uint64(1000-106), leftOverGas)
+
+	_, leftOverGas, err = registry.Call(evm, caller, echoAddr, []byte("hello"), 100, nil, false)
+	require.ErrorIs(t, err, vm.ErrOutOfGas)
+	require.Zero(t, leftOverGas)
+	require.Len(t, stateDB.Logs(), 1)
+}
@@ -23,6 +23,8 @@ type stateObject struct {
 	originStorage Storage
 	// Storage entries that have been modified in the current transaction execution
 	dirtyStorage Storage
+	// Fake storage replacing the storage of the keeper, set by state overrides
+	fakeStorage Storage
 
 	address common.Address
 
@@ -100,6 +102,9 @@ func (s *stateObject) setBalance(amount *big.Int) {
 
 // GetCommittedState query the committed states
 func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
+	if s.fakeStorage != nil {
+		return s.fakeStorage[key]
+	}
 	if value, cached := s.originStorage[key]; cached {
 		return value
 	}
@@ -137,6 +142,17 @@ func (s *stateObject) setState(key, value common.Hash) {
 	s.dirtyStorage[key] = value
 }
 
+// SetStorage replaces the entire storage of the contract, the change is not journaled.
+func (s *stateObject) SetStorage(storage map[common.Hash]common.Hash) {
+	s.fakeStorage = make(Storage, len(storage))
+	for key, value := range storage {
+		s.fakeStorage[key] = value
+	}
+	// the dirty and cached entries are stale
+	s.dirtyStorage = make(Storage)
+	s.originStorage = make(Storage)
+}
+
 // ----------------------------------------------------------------------------
 // 							 attribute accessors
 // ----------------------------------------------------------------------------
@@ -14,9 +14,19 @@ package txs
 //such as the transition to Ethereum 2.0, by allowing transactions to explicitly states their dependencies.
 
 import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	artela "github.com/artela-network/artela/ethereum/types"
 	"github.com/artela-network/artela/x/evm/txs/support"
+	evmmodule "github.com/artela-network/artela/x/evm/types"
+
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
 	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 // AccessList is EIP-2930 access list
@@ -64,3 +74,66 @@ func (al AccessList) ToEthAccessList() *ethereum.AccessList {
 
 	return &ethAccessList
 }
+
+// GasCost returns the intrinsic gas charged for the access list as defined by EIP-2930:
+//
+//	len(addresses) * TxAccessListAddressGas + len(storageKeys) * TxAccessListStorageKeyGas
+//
+// Every tuple is validated before being accounted, and all the arithmetic is overflow
+// checked so that a maliciously large list cannot wrap the result around.
+func (al AccessList) GasCost() (uint64, error) {
+	var storageKeys uint64
+	for i, tuple := range al {
+		if err := artela.ValidateAddress(tuple.Address); err != nil {
+			return 0, errorsmod.Wrapf(err, "invalid access list tuple %d", i)
+		}
+
+		for j, key := range tuple.StorageKeys {
+			if err := validateStorageKey(key); err != nil {
+				return 0, errorsmod.Wrapf(err, "invalid storage key %d of access list tuple %d", j, i)
+			}
+		}
+
+		var overflow bool
+		if storageKeys, overflow = math.SafeAdd(storageKeys, uint64(len(tuple.StorageKeys))); overflow {
+			return 0, errorsmod.Wrap(evmmodule.ErrGasOverflow, "access list storage keys count")
+		}
+	}
+
+	return accessListGas(uint64(len(al)), storageKeys)
+}
+
+// accessListGas computes the EIP-2930 intrinsic gas for the given number of addresses and
+// storage keys, returning ErrGasOverflow if the result doesn't fit into an uint64.
+func accessListGas(addresses, storageKeys uint64) (uint64, error) {
+	addressGas, overflow := math.SafeMul(addresses, params.TxAccessListAddressGas)
+	if overflow {
+		return 0, errorsmod.Wrap(evmmodule.ErrGasOverflow, "access list address gas")
+	}
+
+	storageKeyGas, overflow := math.SafeMul(storageKeys, params.TxAccessListStorageKeyGas)
+	if overflow {
+		return 0, errorsmod.Wrap(evmmodule.ErrGasOverflow, "access list storage key gas")
+	}
+
+	gas, overflow := math.SafeAdd(addressGas, storageKeyGas)
+	if overflow {
+		return 0, errorsmod.Wrap(evmmodule.ErrGasOverflow, "access list gas")
+	}
+
+	return gas, nil
+}
+
+// validateStorageKey returns an error if the key is not a 0x prefixed hex encoded 32 bytes hash.
+func validateStorageKey(key string) error {
+	bz, err := hexutil.Decode(key)
+	if err != nil {
+		return err
+	}
+
+	if len(bz) != common.HashLength {
+		return fmt.Errorf("expected %d bytes, got %d", common.HashLength, len(bz))
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package txs
+
+import (
+	"math"
+	"testing"
+
+	"github.com/artela-network/artela/x/evm/txs/support"
+	evmmodule "github.com/artela-network/artela/x/evm/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessListGasCost(t *testing.T) {
+	gas, err := AccessList{}.GasCost()
+	require.NoError(t, err)
+	require.Zero(t, gas)
+
+	al := AccessList{
+		{
+			Address:     common.HexToAddress("0x1").Hex(),
+			StorageKeys: []string{common.HexToHash("0x1").Hex(), common.HexToHash("0x2").Hex()},
+		},
+		{
+			Address: common.HexToAddress("0x2").Hex(),
+		},
+	}
+	gas, err = al.GasCost()
+	require.NoError(t, err)
+	require.Equal(t, 2*params.TxAccessListAddressGas+2*params.TxAccessListStorageKeyGas, gas)
+
+	_, err = AccessList{{Address: "0xinvalid"}}.GasCost()
+	require.Error(t, err)
+
+	_, err = AccessList{
+		support.AccessTuple{Address: common.HexToAddress("0x1").Hex(), StorageKeys: []string{"0x01"}},
+	}.GasCost()
+	require.Error(t, err)
+}
+
+func TestAccessListGasOverflow(t *testing.T) {
+	_, err := accessListGas(math.MaxUint64/params.TxAccessListAddressGas+1, 0)
+	require.ErrorIs(t, err, evmmodule.ErrGasOverflow)
+
+	_, err = accessListGas(0, math.MaxUint64/params.TxAccessListStorageKeyGas+1)
+	require.ErrorIs(t, err, evmmodule.ErrGasOverflow)
+
+	_, err = accessListGas(math.MaxUint64/params.TxAccessListAddressGas, math.MaxUint64/params.TxAccessListStorageKeyGas)
+	require.ErrorIs(t, err, evmmodule.ErrGasOverflow)
+}
@@ -799,6 +799,12 @@ type EthCallRequest struct {
 	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
 	// chain_id is the eip155 chain id parsed from the requested block header
 	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// overrides is the JSON encoded state overrides of the accounts, applied before
+	// executing the call, uses the same json format as the json rpc api
+	Overrides []byte `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block_overrides is the JSON encoded overrides of the block header fields, the call
+	// is executed in, uses the same json format as the json rpc api
+	BlockOverrides []byte `protobuf:"bytes,6,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
 }
 
 func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
@@ -862,6 +868,20 @@ func (m *EthCallRequest) GetChainId() int64 {
 	return 0
 }
 
+func (m *EthCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *EthCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	// gas returns the estimated gas
@@ -1351,6 +1371,294 @@ func (m *GetSenderResponse) GetSender() string {
 	return ""
 }
 
+// QueryCodeSizeRequest is the request type for the Query/CodeSize RPC method.
+type QueryCodeSizeRequest struct {
+	// address is the ethereum hex address to query the code size for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryCodeSizeRequest) Reset()         { *m = QueryCodeSizeRequest{} }
+func (m *QueryCodeSizeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCodeSizeRequest) ProtoMessage()    {}
+func (*QueryCodeSizeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d7bc138cc47c0d0, []int{25}
+}
+func (m *QueryCodeSizeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCodeSizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCodeSizeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCodeSizeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCodeSizeRequest.Merge(m, src)
+}
+func (m *QueryCodeSizeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCodeSizeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCodeSizeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCodeSizeRequest proto.InternalMessageInfo
+
+// QueryCodeSizeResponse is the response type for the Query/CodeSize RPC
+// method.
+type QueryCodeSizeResponse struct {
+	// code_hash is the keccak256 hash of the account code.
+	CodeHash string `protobuf:"bytes,1,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	// code_size is the length in bytes of the account code.
+	CodeSize uint64 `protobuf:"varint,2,opt,name=code_size,json=codeSize,proto3" json:"code_size,omitempty"`
+}
+
+func (m *QueryCodeSizeResponse) Reset()         { *m = QueryCodeSizeResponse{} }
+func (m *QueryCodeSizeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCodeSizeResponse) ProtoMessage()    {}
+func (*QueryCodeSizeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d7bc138cc47c0d0, []int{26}
+}
+func (m *QueryCodeSizeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCodeSizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCodeSizeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCodeSizeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCodeSizeResponse.Merge(m, src)
+}
+func (m *QueryCodeSizeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCodeSizeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCodeSizeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCodeSizeResponse proto.InternalMessageInfo
+
+func (m *QueryCodeSizeResponse) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+// QueryActiveForksRequest is the request type for the Query/ActiveForks RPC method.
+type QueryActiveForksRequest struct {
+	// height is the block height to query the active forks at, the current height
+	// if zero.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryActiveForksRequest) Reset()         { *m = QueryActiveForksRequest{} }
+func (m *QueryActiveForksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveForksRequest) ProtoMessage()    {}
+func (*QueryActiveForksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d7bc138cc47c0d0, []int{27}
+}
+func (m *QueryActiveForksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveForksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveForksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveForksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveForksRequest.Merge(m, src)
+}
+func (m *QueryActiveForksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveForksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveForksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveForksRequest proto.InternalMessageInfo
+
+// QueryActiveForksResponse is the response type for the Query/ActiveForks RPC
+// method.
+type QueryActiveForksResponse struct {
+	// active_forks are the names of the hard forks activated at the height, in
+	// activation order.
+	ActiveForks []string `protobuf:"bytes,1,rep,name=active_forks,json=activeForks,proto3" json:"active_forks,omitempty"`
+	// next_fork is the name of the next scheduled hard fork, empty if none.
+	NextFork string `protobuf:"bytes,2,opt,name=next_fork,json=nextFork,proto3" json:"next_fork,omitempty"`
+	// next_fork_block is the activation block of the next scheduled hard fork.
+	NextForkBlock int64 `protobuf:"varint,3,opt,name=next_fork_block,json=nextForkBlock,proto3" json:"next_fork_block,omitempty"`
+}
+
+func (m *QueryActiveForksResponse) Reset()         { *m = QueryActiveForksResponse{} }
+func (m *QueryActiveForksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveForksResponse) ProtoMessage()    {}
+func (*QueryActiveForksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d7bc138cc47c0d0, []int{28}
+}
+func (m *QueryActiveForksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveForksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveForksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveForksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveForksResponse.Merge(m, src)
+}
+func (m *QueryActiveForksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveForksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveForksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveForksResponse proto.InternalMessageInfo
+
+func (m *QueryActiveForksResponse) GetActiveForks() []string {
+	if m != nil {
+		return m.ActiveForks
+	}
+	return nil
+}
+
+func (m *QueryActiveForksResponse) GetNextFork() string {
+	if m != nil {
+		return m.NextFork
+	}
+	return ""
+}
+
+// QueryPendingNonceRequest is the request type for the Query/PendingNonce RPC
+// method.
+type QueryPendingNonceRequest struct {
+	// address is the ethereum hex address to query the pending nonce for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryPendingNonceRequest) Reset()         { *m = QueryPendingNonceRequest{} }
+func (m *QueryPendingNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingNonceRequest) ProtoMessage()    {}
+func (*QueryPendingNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d7bc138cc47c0d0, []int{29}
+}
+func (m *QueryPendingNonceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingNonceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingNonceRequest.Merge(m, src)
+}
+func (m *QueryPendingNonceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingNonceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingNonceRequest proto.InternalMessageInfo
+
+// QueryPendingNonceResponse is the response type for the Query/PendingNonce RPC
+// method.
+type QueryPendingNonceResponse struct {
+	// nonce is the next usable nonce of the account.
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *QueryPendingNonceResponse) Reset()         { *m = QueryPendingNonceResponse{} }
+func (m *QueryPendingNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingNonceResponse) ProtoMessage()    {}
+func (*QueryPendingNonceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d7bc138cc47c0d0, []int{30}
+}
+func (m *QueryPendingNonceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingNonceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingNonceResponse.Merge(m, src)
+}
+func (m *QueryPendingNonceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingNonceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingNonceResponse proto.InternalMessageInfo
+
+func (m *QueryPendingNonceResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *QueryActiveForksResponse) GetNextForkBlock() int64 {
+	if m != nil {
+		return m.NextForkBlock
+	}
+	return 0
+}
+
+func (m *QueryCodeSizeResponse) GetCodeSize() uint64 {
+	if m != nil {
+		return m.CodeSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountRequest)(nil), "artela.evm.v1.QueryAccountRequest")
 	proto.RegisterType((*QueryAccountResponse)(nil), "artela.evm.v1.QueryAccountResponse")
@@ -1377,106 +1685,128 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeRequest)(nil), "artela.evm.v1.QueryBaseFeeRequest")
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "artela.evm.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*GetSenderResponse)(nil), "artela.evm.v1.GetSenderResponse")
+	proto.RegisterType((*QueryCodeSizeRequest)(nil), "artela.evm.v1.QueryCodeSizeRequest")
+	proto.RegisterType((*QueryCodeSizeResponse)(nil), "artela.evm.v1.QueryCodeSizeResponse")
+	proto.RegisterType((*QueryActiveForksRequest)(nil), "artela.evm.v1.QueryActiveForksRequest")
+	proto.RegisterType((*QueryActiveForksResponse)(nil), "artela.evm.v1.QueryActiveForksResponse")
+	proto.RegisterType((*QueryPendingNonceRequest)(nil), "artela.evm.v1.QueryPendingNonceRequest")
+	proto.RegisterType((*QueryPendingNonceResponse)(nil), "artela.evm.v1.QueryPendingNonceResponse")
 }
 
 func init() { proto.RegisterFile("artela/evm/v1/query.proto", fileDescriptor_8d7bc138cc47c0d0) }
 
 var fileDescriptor_8d7bc138cc47c0d0 = []byte{
-	// 1500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x1b, 0xcf, 0xc6, 0x4e, 0xec, 0x3c, 0x4e, 0xda, 0x74, 0x9a, 0x36, 0x89, 0x9b, 0xd8, 0xc9, 0xf6,
-	0x6d, 0xe2, 0x7e, 0xed, 0xbe, 0x49, 0xa5, 0xf7, 0x15, 0x48, 0x08, 0xea, 0x28, 0x0d, 0xfd, 0x42,
-	0xc5, 0x8d, 0x38, 0x20, 0x55, 0xd6, 0x78, 0x3d, 0x5d, 0x5b, 0xb1, 0x77, 0xdc, 0x9d, 0xb1, 0x71,
-	0x28, 0x11, 0x52, 0x0f, 0x08, 0x89, 0x4b, 0x25, 0xc4, 0xbd, 0x27, 0x4e, 0xdc, 0xf9, 0x17, 0x7a,
-	0xac, 0xc4, 0x05, 0x71, 0x28, 0xa8, 0xe5, 0xc0, 0x91, 0x33, 0x5c, 0xd0, 0x7c, 0xac, 0xed, 0xdd,
-	0xac, 0xe3, 0x96, 0x8f, 0x1b, 0xa7, 0xdd, 0x99, 0x79, 0xe6, 0xf9, 0xfd, 0x9e, 0x79, 0x9e, 0x99,
-	0xe7, 0x07, 0x8b, 0xd8, 0xe7, 0xa4, 0x81, 0x6d, 0xd2, 0x69, 0xda, 0x9d, 0x0d, 0xfb, 0x41, 0x9b,
-	0xf8, 0xfb, 0x56, 0xcb, 0xa7, 0x9c, 0xa2, 0x19, 0xb5, 0x64, 0x91, 0x4e, 0xd3, 0xea, 0x6c, 0x64,
-	0x2f, 0x38, 0x94, 0x35, 0x29, 0xb3, 0x2b, 0x98, 0x11, 0x65, 0x67, 0x77, 0x36, 0x2a, 0x84, 0xe3,
-	0x0d, 0xbb, 0x85, 0xdd, 0xba, 0x87, 0x79, 0x9d, 0x7a, 0x6a, 0x6b, 0x76, 0x3e, 0xec, 0x55, 0x78,
-	0x50, 0x0b, 0xa7, 0xc3, 0x0b, 0xbc, 0xab, 0xe7, 0xe7, 0x5c, 0xea, 0x52, 0xf9, 0x6b, 0x8b, 0x3f,
-	0x3d, 0xbb, 0xe4, 0x52, 0xea, 0x36, 0x88, 0x8d, 0x5b, 0x75, 0x1b, 0x7b, 0x1e, 0xe5, 0x12, 0x83,
-	0xe9, 0xd5, 0xbc, 0x5e, 0x95, 0xa3, 0x4a, 0xfb, 0xbe, 0xcd, 0xeb, 0x4d, 0xc2, 0x38, 0x6e, 0xb6,
-	0x94, 0x81, 0xf9, 0x06, 0x9c, 0x7c, 0x5f, 0xf0, 0xbc, 0xea, 0x38, 0xb4, 0xed, 0xf1, 0x12, 0x79,
-	0xd0, 0x26, 0x8c, 0xa3, 0x05, 0x48, 0xe1, 0x6a, 0xd5, 0x27, 0x8c, 0x2d, 0x18, 0x2b, 0x46, 0x61,
-	0xaa, 0x14, 0x0c, 0xdf, 0x4c, 0x7f, 0xfe, 0x24, 0x3f, 0xf6, 0xcb, 0x93, 0xfc, 0x98, 0xe9, 0xc0,
-	0x5c, 0x78, 0x2b, 0x6b, 0x51, 0x8f, 0x11, 0xb1, 0xb7, 0x82, 0x1b, 0xd8, 0x73, 0x48, 0xb0, 0x57,
-	0x0f, 0xd1, 0x19, 0x98, 0x72, 0x68, 0x95, 0x94, 0x6b, 0x98, 0xd5, 0x16, 0xc6, 0xe5, 0x5a, 0x5a,
-	0x4c, 0xbc, 0x8b, 0x59, 0x0d, 0xcd, 0xc1, 0x84, 0x47, 0xc5, 0xa6, 0xc4, 0x8a, 0x51, 0x48, 0x96,
-	0xd4, 0xc0, 0x7c, 0x1b, 0x16, 0x25, 0xc8, 0x96, 0x3c, 0xd8, 0x3f, 0xc1, 0xf2, 0x33, 0x03, 0xb2,
-	0x71, 0x1e, 0x34, 0xd9, 0x73, 0x70, 0x4c, 0xe5, 0xac, 0x1c, 0xf6, 0x34, 0xa3, 0x66, 0xaf, 0xaa,
-	0x49, 0x94, 0x85, 0x34, 0x13, 0xa0, 0x82, 0xdf, 0xb8, 0xe4, 0xd7, 0x1b, 0x0b, 0x17, 0x58, 0x79,
-	0x2d, 0x7b, 0xed, 0x66, 0x85, 0xf8, 0x3a, 0x82, 0x19, 0x3d, 0xfb, 0x9e, 0x9c, 0x34, 0x6f, 0xc2,
-	0x92, 0xe4, 0xf1, 0x01, 0x6e, 0xd4, 0xab, 0x98, 0x53, 0x3f, 0x12, 0xcc, 0x2a, 0x4c, 0x3b, 0xd4,
-	0x8b, 0xf2, 0xc8, 0x88, 0xb9, 0xab, 0x87, 0xa2, 0xfa, 0xc2, 0x80, 0xe5, 0x21, 0xde, 0x74, 0x60,
-	0xeb, 0x70, 0x3c, 0x60, 0x15, 0xf6, 0x18, 0x90, 0xfd, 0x1b, 0x43, 0x0b, 0x8a, 0xa8, 0xa8, 0xf2,
-	0xfc, 0x3a, 0xe9, 0xf9, 0xaf, 0x2e, 0xa2, 0xde, 0xd6, 0x51, 0x45, 0x64, 0xde, 0xd4, 0x60, 0x77,
-	0x39, 0xf5, 0xb1, 0x3b, 0x1a, 0x0c, 0xcd, 0x42, 0x62, 0x8f, 0xec, 0xeb, 0x7a, 0x13, 0xbf, 0x03,
-	0xf0, 0x97, 0x34, 0x7c, 0xcf, 0x99, 0x86, 0x9f, 0x83, 0x89, 0x0e, 0x6e, 0xb4, 0x03, 0x70, 0x35,
-	0x30, 0xff, 0x07, 0xb3, 0xba, 0x94, 0xaa, 0xaf, 0x15, 0xe4, 0x3a, 0x9c, 0x18, 0xd8, 0xa7, 0x21,
-	0x10, 0x24, 0x45, 0xed, 0xcb, 0x5d, 0xd3, 0x25, 0xf9, 0x6f, 0x7e, 0x0c, 0x48, 0x1a, 0xee, 0x76,
-	0x6f, 0x51, 0x97, 0x05, 0x10, 0x08, 0x92, 0xf2, 0xc6, 0x28, 0xff, 0xf2, 0x1f, 0x5d, 0x03, 0xe8,
-	0xbf, 0x28, 0x32, 0xb6, 0xcc, 0xe6, 0x9a, 0xa5, 0x8a, 0xd6, 0x12, 0xcf, 0x8f, 0xa5, 0x9e, 0x29,
-	0xfd, 0xfc, 0x58, 0x77, 0xfa, 0x47, 0x55, 0x1a, 0xd8, 0x19, 0xbe, 0x28, 0x27, 0x43, 0xe0, 0x9a,
-	0xe7, 0x1a, 0x24, 0x1b, 0xd4, 0x15, 0xd1, 0x25, 0x0a, 0x99, 0x4d, 0x64, 0x85, 0x5e, 0x3c, 0xeb,
-	0x16, 0x75, 0x4b, 0x72, 0x1d, 0xed, 0xc4, 0x30, 0x5a, 0x1f, 0xc9, 0x48, 0x81, 0x0c, 0x52, 0x32,
-	0xe7, 0xf4, 0x21, 0xdc, 0xc1, 0x3e, 0x6e, 0x06, 0x87, 0x60, 0xde, 0xd0, 0xec, 0x82, 0x59, 0xcd,
-	0xee, 0x0a, 0x4c, 0xb6, 0xe4, 0x8c, 0x3c, 0x9d, 0xcc, 0xe6, 0xa9, 0x08, 0x3f, 0x65, 0x5e, 0x4c,
-	0x3e, 0x7d, 0x9e, 0x1f, 0x2b, 0x69, 0x53, 0xf3, 0x5b, 0x03, 0x8e, 0x6d, 0xf3, 0xda, 0x16, 0x6e,
-	0x34, 0x06, 0xce, 0x18, 0xfb, 0x2e, 0x0b, 0xb2, 0x21, 0xfe, 0xd1, 0x3c, 0xa4, 0x5c, 0xcc, 0xca,
-	0x0e, 0x6e, 0xe9, 0x8b, 0x31, 0xe9, 0x62, 0xb6, 0x85, 0x5b, 0xe8, 0x1e, 0xcc, 0xb6, 0x7c, 0xda,
-	0xa2, 0x8c, 0xf8, 0xbd, 0xcb, 0x25, 0x2e, 0xc6, 0x74, 0x71, 0xf3, 0xb7, 0xe7, 0x79, 0xcb, 0xad,
-	0xf3, 0x5a, 0xbb, 0x62, 0x39, 0xb4, 0x69, 0xeb, 0x7e, 0xa0, 0x3e, 0x97, 0x59, 0x75, 0xcf, 0xe6,
-	0xfb, 0x2d, 0xc2, 0xac, 0xad, 0xfe, 0xad, 0x2e, 0x1d, 0x0f, 0x7c, 0x05, 0x37, 0x72, 0x11, 0xd2,
-	0x4e, 0x0d, 0xd7, 0xbd, 0x72, 0xbd, 0xba, 0x90, 0x5c, 0x31, 0x0a, 0x89, 0x52, 0x4a, 0x8e, 0xaf,
-	0x57, 0xcd, 0x75, 0x38, 0xb9, 0xcd, 0x78, 0xbd, 0x89, 0x39, 0xd9, 0xc1, 0xfd, 0x53, 0x98, 0x85,
-	0x84, 0x8b, 0x15, 0xf9, 0x64, 0x49, 0xfc, 0x9a, 0xbf, 0x27, 0x82, 0x6c, 0xfa, 0xd8, 0x21, 0xbb,
-	0xdd, 0x20, 0x4e, 0x0b, 0x12, 0x4d, 0xe6, 0xea, 0xc3, 0x5a, 0x8a, 0x1c, 0xd6, 0x6d, 0xe6, 0x6e,
-	0xf3, 0x1a, 0xf1, 0x49, 0xbb, 0xb9, 0xdb, 0x2d, 0x09, 0x43, 0xf4, 0x16, 0x4c, 0x73, 0xe1, 0xa1,
-	0xec, 0x50, 0xef, 0x7e, 0xdd, 0x95, 0x61, 0x66, 0x36, 0xb3, 0x91, 0x8d, 0x12, 0x64, 0x4b, 0x5a,
-	0x94, 0x32, 0xbc, 0x3f, 0x40, 0xef, 0xc0, 0x74, 0xcb, 0x27, 0x55, 0xe2, 0x10, 0xc6, 0xa8, 0xcf,
-	0x16, 0x92, 0xb2, 0x88, 0x8e, 0xc6, 0x0d, 0xed, 0x10, 0xcf, 0x62, 0xa5, 0x41, 0x9d, 0xbd, 0xe0,
-	0x01, 0x9a, 0x90, 0x07, 0x92, 0x91, 0x73, 0xea, 0xf9, 0x41, 0xcb, 0x00, 0xca, 0x44, 0xde, 0x92,
-	0x49, 0x79, 0x4b, 0xa6, 0xe4, 0x8c, 0x6c, 0x2c, 0x5b, 0xc1, 0xb2, 0xe8, 0x7d, 0x0b, 0x29, 0x1d,
-	0x80, 0x6a, 0x8c, 0x56, 0xd0, 0x18, 0xad, 0xdd, 0xa0, 0x31, 0x16, 0xd3, 0xa2, 0x56, 0x1e, 0xff,
-	0x98, 0x37, 0xb4, 0x13, 0xb1, 0x12, 0x9b, 0xf2, 0xf4, 0x3f, 0x93, 0xf2, 0xa9, 0x50, 0xca, 0x91,
-	0x09, 0x33, 0x8a, 0x7e, 0x13, 0x77, 0xcb, 0x22, 0xcb, 0x30, 0x70, 0x02, 0xb7, 0x71, 0x77, 0x07,
-	0xb3, 0x1b, 0xc9, 0xf4, 0xf8, 0x6c, 0xa2, 0x94, 0xe6, 0xdd, 0x72, 0xdd, 0xab, 0x92, 0xae, 0x79,
-	0x41, 0x3f, 0x6b, 0xbd, 0xe4, 0xf7, 0xdf, 0x9c, 0x2a, 0xe6, 0x38, 0xa8, 0x72, 0xf1, 0x6f, 0x7e,
-	0x93, 0x80, 0xd3, 0x7d, 0xe3, 0xa2, 0xf0, 0x3a, 0x50, 0x2c, 0xbc, 0x1b, 0xdc, 0xfc, 0x11, 0xc5,
-	0xc2, 0xbb, 0xec, 0xaf, 0x16, 0xcb, 0xbf, 0xa9, 0x1e, 0x9d, 0x6a, 0xf3, 0x32, 0xcc, 0x1f, 0xca,
-	0xd6, 0x11, 0xd9, 0x3d, 0xd5, 0x6b, 0xcd, 0x8c, 0x5c, 0x23, 0x41, 0x0b, 0x30, 0xef, 0xf5, 0xda,
-	0xae, 0x9e, 0xd6, 0x2e, 0xb6, 0x21, 0x2d, 0x9e, 0xea, 0xf2, 0x7d, 0xa2, 0x5b, 0x5f, 0xf1, 0xc2,
-	0x0f, 0xcf, 0xf3, 0x6b, 0xaf, 0x10, 0xf3, 0x75, 0x8f, 0x8b, 0x1e, 0x2d, 0xdd, 0x99, 0x17, 0xe1,
-	0xc4, 0x0e, 0xe1, 0x77, 0x89, 0x57, 0x25, 0x7e, 0xcf, 0xf7, 0x69, 0x98, 0x64, 0x72, 0x46, 0x37,
-	0x32, 0x3d, 0xda, 0xfc, 0x75, 0x1a, 0x26, 0x24, 0x19, 0xf4, 0x09, 0xa4, 0xb4, 0x8c, 0x41, 0x66,
-	0xa4, 0x68, 0x62, 0x44, 0x6a, 0xf6, 0xec, 0x91, 0x36, 0x0a, 0xd5, 0x2c, 0x3c, 0xfa, 0xee, 0xe7,
-	0x2f, 0xc7, 0x4d, 0xb4, 0x62, 0x87, 0x65, 0xb5, 0x56, 0x30, 0xf6, 0x43, 0x9d, 0xe2, 0x03, 0xf4,
-	0x95, 0x01, 0x33, 0x21, 0x91, 0x88, 0x0a, 0x71, 0x00, 0x71, 0x4a, 0x34, 0x7b, 0xfe, 0x15, 0x2c,
-	0x35, 0x21, 0x5b, 0x12, 0x3a, 0x8f, 0xd6, 0x23, 0x84, 0x02, 0x19, 0x7a, 0x88, 0xd7, 0xd7, 0x06,
-	0xcc, 0x46, 0x65, 0x1e, 0xba, 0x18, 0x07, 0x38, 0x44, 0x5a, 0x66, 0x2f, 0xbd, 0x9a, 0xb1, 0x26,
-	0xf8, 0x7f, 0x49, 0x70, 0x03, 0xd9, 0x11, 0x82, 0x9d, 0x60, 0x43, 0x9f, 0xe3, 0xa0, 0x60, 0x3d,
-	0x40, 0x07, 0x90, 0xd2, 0x32, 0x2e, 0x3e, 0x7d, 0x61, 0x79, 0x18, 0x9f, 0xbe, 0x88, 0x0e, 0x34,
-	0xcf, 0x4b, 0x32, 0x67, 0xd1, 0x6a, 0x84, 0x8c, 0x56, 0x83, 0x6c, 0xe0, 0x9c, 0x1e, 0x19, 0x90,
-	0xd2, 0x3a, 0x2e, 0x1e, 0x3f, 0xac, 0x18, 0xe3, 0xf1, 0x23, 0x42, 0xd0, 0xb4, 0x24, 0x7e, 0x01,
-	0xad, 0x45, 0xf0, 0x99, 0xb2, 0xeb, 0xc3, 0xdb, 0x0f, 0xf7, 0xc8, 0xfe, 0x01, 0x7a, 0x00, 0x49,
-	0xa1, 0xf2, 0x50, 0x3e, 0xbe, 0x20, 0x7a, 0xba, 0x31, 0xbb, 0x32, 0xdc, 0x40, 0x43, 0xaf, 0x49,
-	0xe8, 0x15, 0x94, 0x3b, 0x54, 0x28, 0xd5, 0x50, 0xdc, 0x1e, 0x4c, 0x2a, 0x95, 0x83, 0x56, 0xe3,
-	0x7c, 0x86, 0x64, 0x54, 0xd6, 0x3c, 0xca, 0x44, 0x03, 0x2f, 0x4b, 0xe0, 0x79, 0x74, 0x2a, 0x02,
-	0xac, 0xd4, 0x13, 0xa2, 0x90, 0xd2, 0xe2, 0x09, 0x2d, 0x47, 0xbc, 0x85, 0x45, 0x55, 0xf6, 0x3f,
-	0x47, 0xb6, 0x8c, 0x00, 0x2e, 0x2f, 0xe1, 0x16, 0xd1, 0x7c, 0x04, 0x8e, 0xf0, 0x5a, 0xd9, 0x11,
-	0x28, 0x6d, 0xc8, 0x0c, 0x88, 0x9e, 0x51, 0xa0, 0xd1, 0x08, 0x63, 0xf4, 0x92, 0x79, 0x56, 0x42,
-	0x2e, 0xa3, 0x33, 0x51, 0x48, 0x6d, 0x2b, 0x1e, 0x5f, 0xc4, 0x20, 0xa5, 0xfb, 0x67, 0x7c, 0x39,
-	0x85, 0x95, 0x55, 0x7c, 0x39, 0x45, 0x1a, 0xf0, 0xd0, 0x58, 0x55, 0xdb, 0xe4, 0x5d, 0xf4, 0x29,
-	0x40, 0xff, 0x65, 0x47, 0xe7, 0x86, 0xfa, 0x1c, 0xec, 0xd3, 0xd9, 0xb5, 0x51, 0x66, 0x1a, 0xdd,
-	0x94, 0xe8, 0x4b, 0x28, 0x1b, 0x8b, 0x2e, 0xbb, 0x8c, 0x88, 0x5a, 0x37, 0x85, 0x61, 0x97, 0x78,
-	0xb0, 0x91, 0x0c, 0xbb, 0xc4, 0xa1, 0xae, 0x32, 0x34, 0xea, 0xa0, 0xd5, 0x20, 0x0f, 0xa6, 0x7a,
-	0xfd, 0x02, 0x1d, 0x29, 0x34, 0x0e, 0xdd, 0x9b, 0x43, 0x7d, 0xc6, 0x5c, 0x95, 0x68, 0x67, 0xd0,
-	0x62, 0x04, 0xcd, 0x25, 0xbc, 0xac, 0x5a, 0x4e, 0xf1, 0xfa, 0xd3, 0x17, 0x39, 0xe3, 0xd9, 0x8b,
-	0x9c, 0xf1, 0xd3, 0x8b, 0x9c, 0xf1, 0xf8, 0x65, 0x6e, 0xec, 0xd9, 0xcb, 0xdc, 0xd8, 0xf7, 0x2f,
-	0x73, 0x63, 0x1f, 0xda, 0x03, 0xad, 0x4e, 0x6d, 0xbf, 0xec, 0x11, 0xfe, 0x11, 0xf5, 0xf7, 0x02,
-	0x6f, 0x9d, 0x0d, 0xbb, 0x2b, 0x5d, 0xca, 0xbe, 0x57, 0x99, 0x94, 0xb2, 0xe2, 0xca, 0x1f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x1a, 0xbc, 0x1c, 0x46, 0x25, 0x12, 0x00, 0x00,
+	// 1748 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xed, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xef, 0xc6, 0x4e, 0xec, 0x8c, 0x93, 0x36, 0x9d, 0x26, 0x4d, 0xb2, 0x4d, 0xea, 0x64, 0xd2,
+	0x3a, 0x69, 0xda, 0xee, 0x92, 0x54, 0x02, 0x51, 0xc4, 0x47, 0x12, 0xb5, 0xa5, 0x85, 0x96, 0xd6,
+	0xad, 0x38, 0x20, 0x55, 0xd6, 0xd8, 0x9e, 0xac, 0x57, 0xb1, 0xbd, 0xee, 0xee, 0x3a, 0xb8, 0x2d,
+	0x11, 0xa2, 0x07, 0x84, 0x04, 0x87, 0x22, 0x84, 0xb8, 0x72, 0xe2, 0xc4, 0x1f, 0xd2, 0x63, 0x25,
+	0x2e, 0x88, 0x43, 0x41, 0xc0, 0x81, 0xbf, 0x01, 0x24, 0xc4, 0x7c, 0x7a, 0x3f, 0xbc, 0x8e, 0x1b,
+	0x3e, 0x6e, 0x1c, 0x2c, 0xef, 0xcc, 0xbc, 0x79, 0xbf, 0xdf, 0xbc, 0x79, 0xef, 0xcd, 0x7b, 0x60,
+	0x16, 0xbb, 0x3e, 0xa9, 0x63, 0x93, 0xec, 0x36, 0xcc, 0xdd, 0x35, 0xf3, 0x5e, 0x9b, 0xb8, 0xf7,
+	0x8d, 0x96, 0xeb, 0xf8, 0x0e, 0x1c, 0x17, 0x4b, 0x06, 0x5d, 0x32, 0x76, 0xd7, 0xf4, 0xd5, 0x8a,
+	0xe3, 0x35, 0x1c, 0xcf, 0x2c, 0x63, 0x8f, 0x08, 0x39, 0xba, 0xa1, 0x4c, 0x7c, 0xbc, 0x66, 0xb6,
+	0xb0, 0x65, 0x37, 0xb1, 0x6f, 0x3b, 0x4d, 0xb1, 0x55, 0x9f, 0x8e, 0x6a, 0x65, 0x1a, 0xc4, 0xc2,
+	0xf1, 0xe8, 0x82, 0xdf, 0x91, 0xf3, 0x93, 0x96, 0x63, 0x39, 0xfc, 0xd3, 0x64, 0x5f, 0x72, 0x76,
+	0xce, 0x72, 0x1c, 0xab, 0x4e, 0x4c, 0xdc, 0xb2, 0x4d, 0xdc, 0x6c, 0x3a, 0x3e, 0xc7, 0xf0, 0xe4,
+	0x6a, 0x5e, 0xae, 0xf2, 0x51, 0xb9, 0xbd, 0x6d, 0xfa, 0x76, 0x83, 0x78, 0x3e, 0x6e, 0xb4, 0x84,
+	0x00, 0x7a, 0x19, 0x1c, 0xbb, 0xc5, 0x78, 0x6e, 0x54, 0x2a, 0x4e, 0xbb, 0xe9, 0x17, 0x09, 0x65,
+	0xed, 0xf9, 0x70, 0x06, 0x64, 0x70, 0xb5, 0xea, 0x12, 0xcf, 0x9b, 0xd1, 0x16, 0xb4, 0x95, 0xd1,
+	0xa2, 0x1a, 0x5e, 0xcc, 0x7e, 0xf2, 0x75, 0xfe, 0xd0, 0x6f, 0xf4, 0x87, 0x2a, 0x60, 0x32, 0xba,
+	0xd5, 0x6b, 0x51, 0x60, 0xc2, 0xf6, 0x96, 0x71, 0x1d, 0x37, 0x2b, 0x44, 0xed, 0x95, 0x43, 0x78,
+	0x02, 0x8c, 0x56, 0x9c, 0x2a, 0x29, 0xd5, 0xb0, 0x57, 0x9b, 0x19, 0xe2, 0x6b, 0x59, 0x36, 0xf1,
+	0x26, 0x1d, 0xc3, 0x49, 0x30, 0xdc, 0x74, 0xd8, 0xa6, 0x14, 0x5d, 0x48, 0x17, 0xc5, 0x00, 0xbd,
+	0x0e, 0x66, 0x39, 0xc8, 0x16, 0x37, 0xec, 0xdf, 0x60, 0xf9, 0xb1, 0x06, 0xf4, 0x24, 0x0d, 0x92,
+	0xec, 0x69, 0x70, 0x58, 0xdc, 0x59, 0x29, 0xaa, 0x69, 0x5c, 0xcc, 0x6e, 0x88, 0x49, 0xa8, 0x83,
+	0xac, 0xc7, 0x40, 0x19, 0xbf, 0x21, 0xce, 0xaf, 0x3b, 0x66, 0x2a, 0xb0, 0xd0, 0x5a, 0x6a, 0xb6,
+	0x1b, 0x65, 0xe2, 0xca, 0x13, 0x8c, 0xcb, 0xd9, 0x1b, 0x7c, 0x12, 0xbd, 0x05, 0xe6, 0x38, 0x8f,
+	0x77, 0x71, 0xdd, 0xae, 0x62, 0xdf, 0x71, 0x63, 0x87, 0x59, 0x04, 0x63, 0x15, 0x4a, 0x29, 0xc6,
+	0x23, 0xc7, 0xe6, 0x36, 0x7a, 0x4e, 0xf5, 0xa9, 0x06, 0xe6, 0xfb, 0x68, 0x93, 0x07, 0x5b, 0x06,
+	0x47, 0x14, 0xab, 0xa8, 0x46, 0x45, 0xf6, 0x5f, 0x3c, 0x9a, 0x72, 0xa2, 0x4d, 0x71, 0xcf, 0x07,
+	0xb9, 0x9e, 0x17, 0xa4, 0x13, 0x75, 0xb7, 0x0e, 0x72, 0x22, 0x6a, 0x47, 0x01, 0x76, 0x9b, 0x1e,
+	0x1a, 0x5b, 0x83, 0xc1, 0xe0, 0x04, 0x48, 0xed, 0x90, 0xfb, 0xd2, 0xdf, 0xd8, 0x67, 0x08, 0xfe,
+	0x9c, 0x84, 0xef, 0x2a, 0x93, 0xf0, 0xd4, 0x19, 0x77, 0x71, 0xbd, 0xad, 0xc0, 0xc5, 0x00, 0xbd,
+	0x08, 0x26, 0xa4, 0x2b, 0x55, 0x0f, 0x74, 0xc8, 0x65, 0x70, 0x34, 0xb4, 0x4f, 0x42, 0x40, 0x90,
+	0x66, 0xbe, 0xcf, 0x77, 0x8d, 0x15, 0xf9, 0x37, 0x7a, 0x00, 0x20, 0x17, 0xbc, 0xd3, 0x79, 0xdb,
+	0xb1, 0x3c, 0x05, 0x41, 0x25, 0x79, 0xc4, 0x08, 0xfd, 0xfc, 0x1b, 0x5e, 0x06, 0x20, 0xc8, 0x28,
+	0xfc, 0x6c, 0xb9, 0xf5, 0x82, 0x21, 0x9c, 0xd6, 0x60, 0xe9, 0xc7, 0x10, 0x69, 0x4a, 0xa6, 0x1f,
+	0xe3, 0x66, 0x60, 0xaa, 0x62, 0x68, 0x67, 0x34, 0x50, 0x8e, 0x45, 0xc0, 0x25, 0xcf, 0x02, 0x48,
+	0xd7, 0xe9, 0x98, 0xa2, 0xa7, 0x28, 0x06, 0x34, 0x22, 0x19, 0xcf, 0xa0, 0xa2, 0x45, 0xbe, 0x0e,
+	0xaf, 0x24, 0x30, 0x5a, 0x1e, 0xc8, 0x48, 0x80, 0x84, 0x29, 0xa1, 0x49, 0x69, 0x84, 0x9b, 0xd8,
+	0xc5, 0x0d, 0x65, 0x04, 0x74, 0x4d, 0xb2, 0x53, 0xb3, 0x92, 0xdd, 0x05, 0x30, 0xd2, 0xe2, 0x33,
+	0xdc, 0x3a, 0xb9, 0xf5, 0xa9, 0x18, 0x3f, 0x21, 0xbe, 0x99, 0x7e, 0xf2, 0x2c, 0x7f, 0xa8, 0x28,
+	0x45, 0xd1, 0x9f, 0x1a, 0x38, 0x7c, 0xc9, 0xaf, 0x6d, 0xe1, 0x7a, 0x3d, 0x64, 0x63, 0xec, 0x5a,
+	0x9e, 0xba, 0x0d, 0xf6, 0x0d, 0xa7, 0x41, 0xc6, 0xc2, 0x5e, 0xa9, 0x82, 0x5b, 0x32, 0x30, 0x46,
+	0xe8, 0x70, 0x0b, 0xb7, 0xe0, 0x5d, 0x30, 0x41, 0xb3, 0x67, 0xcb, 0xf1, 0x88, 0xdb, 0x0d, 0x2e,
+	0x16, 0x18, 0x63, 0x9b, 0xeb, 0xbf, 0x3f, 0xcb, 0x1b, 0x96, 0xed, 0xd7, 0xda, 0x65, 0x7a, 0xf4,
+	0x86, 0x29, 0xdf, 0x03, 0xf1, 0x77, 0xde, 0xab, 0xee, 0x98, 0xfe, 0xfd, 0x16, 0xf1, 0x8c, 0xad,
+	0x20, 0xaa, 0x8b, 0x47, 0x94, 0x2e, 0x15, 0x91, 0xb3, 0x20, 0x5b, 0xa9, 0x61, 0xbb, 0x59, 0xb2,
+	0xab, 0x33, 0x69, 0xaa, 0x36, 0x55, 0xcc, 0xf0, 0xf1, 0xd5, 0x2a, 0x9c, 0x03, 0xa3, 0xce, 0x2e,
+	0x71, 0x5d, 0xbb, 0x4a, 0xbc, 0x99, 0x61, 0xce, 0x35, 0x98, 0x60, 0x31, 0x5f, 0xae, 0x3b, 0x95,
+	0x9d, 0x52, 0x20, 0x33, 0xc2, 0x65, 0x0e, 0xf3, 0xe9, 0x77, 0xd4, 0x2c, 0x75, 0xc8, 0x63, 0x97,
+	0x3c, 0xfa, 0x14, 0x60, 0x9f, 0x5c, 0xc1, 0x81, 0x31, 0x69, 0xa4, 0xd0, 0x13, 0x72, 0x1b, 0xa4,
+	0x8b, 0xec, 0x13, 0xfd, 0x91, 0x52, 0x4e, 0xe1, 0xe2, 0x0a, 0xb9, 0xd3, 0x51, 0xe6, 0x32, 0x40,
+	0xaa, 0xe1, 0x59, 0xd2, 0xe6, 0x73, 0x31, 0x9b, 0x5f, 0xf7, 0x2c, 0x6a, 0x5d, 0xe2, 0x92, 0x76,
+	0x83, 0xee, 0x60, 0x82, 0xf0, 0x55, 0x30, 0xe6, 0x33, 0x0d, 0x25, 0x9a, 0xce, 0xb6, 0x6d, 0x8b,
+	0x5b, 0x2b, 0xb7, 0xae, 0xc7, 0x36, 0x72, 0x90, 0x2d, 0x2e, 0x51, 0xcc, 0xf9, 0xc1, 0x00, 0xbe,
+	0x01, 0xc6, 0x5a, 0x2e, 0xa9, 0x92, 0x0a, 0x35, 0x8f, 0xe3, 0x7a, 0xd4, 0x2a, 0xa9, 0x81, 0xb8,
+	0x91, 0x1d, 0x2c, 0xbb, 0x0a, 0xd3, 0xc8, 0x3c, 0x36, 0xcc, 0xed, 0x9a, 0xe3, 0x73, 0x22, 0x8b,
+	0xc1, 0x79, 0x00, 0x84, 0x08, 0x0f, 0xb6, 0x11, 0x1e, 0x6c, 0xa3, 0x7c, 0x86, 0xbf, 0x4f, 0x5b,
+	0x6a, 0x99, 0x3d, 0xa1, 0x33, 0x19, 0x79, 0x00, 0xf1, 0xbe, 0x1a, 0xea, 0x7d, 0x35, 0xee, 0xa8,
+	0xf7, 0x75, 0x33, 0xcb, 0x5c, 0xee, 0xf1, 0x8f, 0x79, 0x4d, 0x2a, 0x61, 0x2b, 0x89, 0x9e, 0x93,
+	0xfd, 0x6f, 0x3c, 0x67, 0x34, 0xea, 0x39, 0x08, 0x8c, 0x0b, 0xfa, 0x0d, 0xdc, 0x29, 0xb1, 0x5b,
+	0x06, 0x21, 0x0b, 0x5c, 0xc7, 0x1d, 0xea, 0x07, 0xd7, 0xd2, 0xd9, 0xa1, 0x89, 0x54, 0x31, 0xeb,
+	0x77, 0x4a, 0x76, 0xb3, 0x4a, 0x3a, 0x68, 0x55, 0x66, 0xc7, 0xee, 0xe5, 0x07, 0xa9, 0x8b, 0xbe,
+	0x39, 0x58, 0x05, 0x0b, 0xfb, 0x46, 0xdf, 0xa6, 0xc0, 0xf1, 0x40, 0x78, 0x93, 0x69, 0x0d, 0x39,
+	0x8b, 0xdf, 0x51, 0x09, 0x64, 0x80, 0xb3, 0x50, 0xc1, 0x7f, 0xea, 0x2c, 0xff, 0x5f, 0xf5, 0xe0,
+	0xab, 0x46, 0xe7, 0xc1, 0x74, 0xcf, 0x6d, 0xed, 0x73, 0xbb, 0x53, 0xdd, 0x17, 0xde, 0x23, 0x97,
+	0x89, 0x7a, 0x49, 0xd0, 0xdd, 0xee, 0xeb, 0x2d, 0xa7, 0xa5, 0x8a, 0x4b, 0x20, 0xcb, 0x32, 0x7e,
+	0x69, 0x9b, 0xc8, 0x17, 0x74, 0x73, 0xf5, 0x87, 0x67, 0xf9, 0xc2, 0x73, 0x9c, 0xf9, 0x2a, 0x2d,
+	0x61, 0x32, 0x65, 0xa1, 0x0e, 0x9d, 0x05, 0x47, 0xaf, 0x10, 0xff, 0x36, 0xa1, 0xce, 0xe8, 0x76,
+	0x75, 0x1f, 0x07, 0x23, 0x1e, 0x9f, 0x91, 0xef, 0xa1, 0x1c, 0xa1, 0x8b, 0x92, 0x0b, 0x7b, 0x64,
+	0x6f, 0xdb, 0x0f, 0x0e, 0xf4, 0x40, 0xdf, 0x02, 0x53, 0xb1, 0xbd, 0x12, 0x2c, 0x52, 0xb1, 0x6a,
+	0xb1, 0x8a, 0x55, 0x2d, 0x7a, 0x74, 0x87, 0x2a, 0x9d, 0x2a, 0x52, 0x03, 0x7a, 0x45, 0x1a, 0x78,
+	0xa3, 0xe2, 0xdb, 0xbb, 0xe4, 0xb2, 0xe3, 0xee, 0x74, 0xdf, 0x73, 0x7a, 0x82, 0x1a, 0xb1, 0xad,
+	0x9a, 0xcf, 0x35, 0xa6, 0x8a, 0x72, 0x14, 0xe2, 0xf3, 0x48, 0x03, 0x33, 0xbd, 0xbb, 0x25, 0x27,
+	0xea, 0xdf, 0x98, 0x4f, 0x97, 0xb6, 0xd9, 0x3c, 0x8f, 0x2b, 0x5a, 0x28, 0xe2, 0x40, 0x94, 0x31,
+	0x6b, 0x92, 0x8e, 0xcf, 0x05, 0x54, 0xa1, 0xcd, 0x26, 0xd8, 0x2a, 0x7d, 0xd0, 0x8f, 0x74, 0x17,
+	0x4b, 0xdc, 0x27, 0x78, 0x84, 0xa5, 0x8a, 0xe3, 0x4a, 0x84, 0xfb, 0x03, 0x7a, 0x4d, 0x72, 0xb8,
+	0x49, 0xed, 0x6b, 0x37, 0xad, 0x1b, 0xce, 0x01, 0x4b, 0xbb, 0x35, 0x59, 0xba, 0x47, 0xf7, 0x07,
+	0x05, 0x96, 0xa8, 0xf6, 0xb5, 0x50, 0xb5, 0xbf, 0xfe, 0xd5, 0x04, 0x18, 0xe6, 0x7b, 0xe0, 0x07,
+	0x20, 0x23, 0x2b, 0x5a, 0x88, 0x62, 0x81, 0x9f, 0xd0, 0xaf, 0xe8, 0x4b, 0xfb, 0xca, 0x08, 0x4c,
+	0xb4, 0xf2, 0xe8, 0xbb, 0x5f, 0xbf, 0x18, 0x42, 0x70, 0xc1, 0x8c, 0x76, 0x58, 0xb2, 0x98, 0x35,
+	0x1f, 0xca, 0x33, 0xec, 0xc1, 0x2f, 0x35, 0x30, 0x1e, 0xe9, 0x17, 0xe0, 0x4a, 0x12, 0x40, 0x52,
+	0x53, 0xa2, 0x9f, 0x79, 0x0e, 0x49, 0x49, 0xc8, 0xe4, 0x84, 0xce, 0xc0, 0xe5, 0x18, 0x21, 0xd5,
+	0x91, 0xf4, 0xf0, 0xfa, 0x46, 0x03, 0x13, 0xf1, 0x8a, 0x1f, 0x9e, 0x4d, 0x02, 0xec, 0xd3, 0x65,
+	0xe8, 0xe7, 0x9e, 0x4f, 0x58, 0x12, 0x7c, 0x89, 0x13, 0x5c, 0x83, 0x66, 0x8c, 0xe0, 0xae, 0xda,
+	0x10, 0x70, 0x0c, 0xf7, 0x2e, 0x7b, 0x70, 0x0f, 0x64, 0x64, 0x45, 0x9f, 0x7c, 0x7d, 0xd1, 0x4e,
+	0x21, 0xf9, 0xfa, 0x62, 0x2d, 0x01, 0x3a, 0xc3, 0xc9, 0x2c, 0xc1, 0xc5, 0x18, 0x19, 0xd9, 0x18,
+	0x78, 0x21, 0x3b, 0xd1, 0xf8, 0xc9, 0xc8, 0x92, 0x3e, 0x19, 0x3f, 0xda, 0x3c, 0x24, 0xe3, 0xc7,
+	0x7a, 0x02, 0x64, 0x70, 0xfc, 0x15, 0x58, 0x88, 0xe1, 0x7b, 0x42, 0x2e, 0x80, 0x37, 0x1f, 0xd2,
+	0x26, 0x63, 0x0f, 0xde, 0x03, 0x69, 0x96, 0x4f, 0x60, 0x3e, 0xd9, 0x21, 0xba, 0x2d, 0x84, 0xbe,
+	0xd0, 0x5f, 0x40, 0x42, 0x17, 0x38, 0xf4, 0x02, 0x3c, 0xd9, 0xe3, 0x28, 0xd5, 0xc8, 0xb9, 0x3f,
+	0xd2, 0x40, 0x56, 0xe5, 0x30, 0xb8, 0xd4, 0x4f, 0x6d, 0x28, 0x3b, 0xea, 0xa7, 0xf6, 0x17, 0x92,
+	0xf8, 0xab, 0x1c, 0xff, 0x14, 0x44, 0x09, 0xf8, 0x3c, 0xfd, 0x85, 0x38, 0x7c, 0xa6, 0x81, 0x5c,
+	0x28, 0x6d, 0xc1, 0x42, 0x72, 0x68, 0xc6, 0xb3, 0xa2, 0xbe, 0x3c, 0x50, 0x4e, 0x92, 0x39, 0xc7,
+	0xc9, 0x14, 0xe0, 0xa9, 0x9e, 0x30, 0x0e, 0x92, 0xa2, 0xf9, 0x50, 0xe4, 0xd4, 0x3d, 0xf8, 0xb9,
+	0x06, 0xc6, 0xc2, 0x19, 0x08, 0x26, 0xe2, 0x24, 0xe4, 0x38, 0x7d, 0x65, 0xb0, 0xe0, 0x00, 0xcf,
+	0x68, 0x09, 0xe1, 0x12, 0x4f, 0x6e, 0x21, 0x13, 0x35, 0xc1, 0x88, 0xe8, 0x4b, 0xe0, 0x62, 0x22,
+	0x46, 0xb8, 0xf1, 0xd1, 0xd1, 0x7e, 0x22, 0x92, 0xc0, 0x3c, 0x27, 0x30, 0x0d, 0xa7, 0xe2, 0x04,
+	0x04, 0x8a, 0x03, 0x32, 0xb2, 0xdd, 0x81, 0xf3, 0x31, 0x6d, 0xd1, 0x36, 0xa8, 0xc7, 0x1d, 0xa2,
+	0xd5, 0x99, 0x82, 0xcb, 0x73, 0xb8, 0x59, 0x38, 0x1d, 0x83, 0x23, 0x7e, 0x8d, 0x76, 0x4b, 0x14,
+	0xa5, 0x0d, 0x72, 0xa1, 0xfe, 0x62, 0x10, 0x68, 0xfc, 0x84, 0x09, 0xad, 0x09, 0x5a, 0xe2, 0x90,
+	0xf3, 0xf0, 0x44, 0x1c, 0x52, 0xca, 0xb2, 0x3a, 0x07, 0x7a, 0x20, 0x23, 0x4b, 0xd5, 0xe4, 0xa8,
+	0x8f, 0x36, 0x31, 0xc9, 0x51, 0x1f, 0xab, 0x75, 0xfb, 0x9e, 0x55, 0x54, 0xa8, 0x7e, 0x07, 0x7e,
+	0x08, 0x40, 0x50, 0x44, 0xc1, 0xd3, 0x7d, 0x75, 0x86, 0x4b, 0x62, 0xbd, 0x30, 0x48, 0x4c, 0xa2,
+	0x23, 0x8e, 0x3e, 0x07, 0xf5, 0x44, 0x74, 0xfe, 0x78, 0xb3, 0x53, 0xcb, 0xfa, 0xab, 0x5f, 0xae,
+	0x0d, 0xd7, 0x6c, 0xfd, 0x72, 0x6d, 0xa4, 0x80, 0xeb, 0x7b, 0x6a, 0x55, 0xd5, 0x51, 0x17, 0x1e,
+	0xed, 0x96, 0x66, 0x70, 0xdf, 0x9a, 0xbe, 0x27, 0xbd, 0xf5, 0x94, 0x74, 0x68, 0x91, 0xa3, 0x9d,
+	0x80, 0xb3, 0x31, 0x34, 0x8b, 0xf8, 0x25, 0x51, 0xdd, 0x6d, 0x5e, 0x7d, 0xf2, 0xf3, 0x49, 0xed,
+	0x29, 0xfd, 0xfd, 0x44, 0x7f, 0x8f, 0x7f, 0x39, 0x79, 0xe8, 0x29, 0xfd, 0x7d, 0x4f, 0x7f, 0xef,
+	0x99, 0xa1, 0xaa, 0x52, 0x6c, 0x3f, 0xdf, 0x24, 0xfe, 0xfb, 0x34, 0x0b, 0x28, 0x6d, 0x54, 0x53,
+	0x87, 0xab, 0xe4, 0x25, 0x66, 0x79, 0x84, 0x57, 0xf0, 0x17, 0xfe, 0x02, 0xd7, 0xc5, 0x47, 0xed,
+	0xd7, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1505,6 +1835,15 @@ type QueryClient interface {
 	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
+	// CodeSize queries the code hash and the code size of a single account,
+	// without returning the code itself.
+	CodeSize(ctx context.Context, in *QueryCodeSizeRequest, opts ...grpc.CallOption) (*QueryCodeSizeResponse, error)
+	// ActiveForks queries the hard forks activated at a given height and the next
+	// scheduled fork.
+	ActiveForks(ctx context.Context, in *QueryActiveForksRequest, opts ...grpc.CallOption) (*QueryActiveForksResponse, error)
+	// PendingNonce queries the next nonce of an account, including the transactions
+	// of the account queued in the application mempool.
+	PendingNonce(ctx context.Context, in *QueryPendingNonceRequest, opts ...grpc.CallOption) (*QueryPendingNonceResponse, error)
 	// Params queries the parameters of x/evm module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
@@ -1584,6 +1923,33 @@ func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) CodeSize(ctx context.Context, in *QueryCodeSizeRequest, opts ...grpc.CallOption) (*QueryCodeSizeResponse, error) {
+	out := new(QueryCodeSizeResponse)
+	err := c.cc.Invoke(ctx, "/artela.evm.v1.Query/CodeSize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActiveForks(ctx context.Context, in *QueryActiveForksRequest, opts ...grpc.CallOption) (*QueryActiveForksResponse, error) {
+	out := new(QueryActiveForksResponse)
+	err := c.cc.Invoke(ctx, "/artela.evm.v1.Query/ActiveForks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingNonce(ctx context.Context, in *QueryPendingNonceRequest, opts ...grpc.CallOption) (*QueryPendingNonceResponse, error) {
+	out := new(QueryPendingNonceResponse)
+	err := c.cc.Invoke(ctx, "/artela.evm.v1.Query/PendingNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/artela.evm.v1.Query/Params", in, out, opts...)
@@ -1663,6 +2029,15 @@ type QueryServer interface {
 	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
+	// CodeSize queries the code hash and the code size of a single account,
+	// without returning the code itself.
+	CodeSize(context.Context, *QueryCodeSizeRequest) (*QueryCodeSizeResponse, error)
+	// ActiveForks queries the hard forks activated at a given height and the next
+	// scheduled fork.
+	ActiveForks(context.Context, *QueryActiveForksRequest) (*QueryActiveForksResponse, error)
+	// PendingNonce queries the next nonce of an account, including the transactions
+	// of the account queued in the application mempool.
+	PendingNonce(context.Context, *QueryPendingNonceRequest) (*QueryPendingNonceResponse, error)
 	// Params queries the parameters of x/evm module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
@@ -1702,6 +2077,15 @@ func (*UnimplementedQueryServer) Storage(ctx context.Context, req *QueryStorageR
 func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest) (*QueryCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
 }
+func (*UnimplementedQueryServer) CodeSize(ctx context.Context, req *QueryCodeSizeRequest) (*QueryCodeSizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CodeSize not implemented")
+}
+func (*UnimplementedQueryServer) ActiveForks(ctx context.Context, req *QueryActiveForksRequest) (*QueryActiveForksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveForks not implemented")
+}
+func (*UnimplementedQueryServer) PendingNonce(ctx context.Context, req *QueryPendingNonceRequest) (*QueryPendingNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingNonce not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -1836,6 +2220,60 @@ func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CodeSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCodeSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CodeSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.evm.v1.Query/CodeSize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CodeSize(ctx, req.(*QueryCodeSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActiveForks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveForksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveForks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.evm.v1.Query/ActiveForks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveForks(ctx, req.(*QueryActiveForksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.evm.v1.Query/PendingNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingNonce(ctx, req.(*QueryPendingNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -1990,6 +2428,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Code",
 			Handler:    _Query_Code_Handler,
 		},
+		{
+			MethodName: "CodeSize",
+			Handler:    _Query_CodeSize_Handler,
+		},
+		{
+			MethodName: "ActiveForks",
+			Handler:    _Query_ActiveForks_Handler,
+		},
+		{
+			MethodName: "PendingNonce",
+			Handler:    _Query_PendingNonce_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -2589,6 +3039,20 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ChainId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
@@ -2976,16 +3440,211 @@ func (m *GetSenderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryCodeSizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeSizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeSizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCodeSizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeSizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeSizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CodeSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CodeSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveForksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveForksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveForksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveForksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveForksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveForksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextForkBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextForkBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NextFork) > 0 {
+		i -= len(m.NextFork)
+		copy(dAtA[i:], m.NextFork)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NextFork)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ActiveForks) > 0 {
+		for iNdEx := len(m.ActiveForks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActiveForks[iNdEx])
+			copy(dAtA[i:], m.ActiveForks[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ActiveForks[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *QueryAccountRequest) Size() (n int) {
 	if m == nil {
@@ -3242,6 +3901,14 @@ func (m *EthCallRequest) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovQuery(uint64(m.ChainId))
 	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3398,6 +4065,94 @@ func (m *GetSenderResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCodeSizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeSizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CodeSize != 0 {
+		n += 1 + sovQuery(uint64(m.CodeSize))
+	}
+	return n
+}
+
+func (m *QueryActiveForksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryActiveForksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActiveForks) > 0 {
+		for _, s := range m.ActiveForks {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.NextFork)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NextForkBlock != 0 {
+		n += 1 + sovQuery(uint64(m.NextForkBlock))
+	}
+	return n
+}
+
+func (m *QueryPendingNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5055,17 +5810,85 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -6119,6 +6942,542 @@ func (m *GetSenderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCodeSizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeSizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeSizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodeSizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeSizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeSizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeSize", wireType)
+			}
+			m.CodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveForksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveForksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveForksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveForksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveForksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveForksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveForks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveForks = append(m.ActiveForks, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextFork", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextFork = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextForkBlock", wireType)
+			}
+			m.NextForkBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextForkBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
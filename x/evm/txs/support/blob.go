@@ -0,0 +1,66 @@
+package support
+
+import (
+	"math/big"
+)
+
+// ----------------------------------------------------------------------------
+// 								 EIP-4844 Blob Gas
+// ----------------------------------------------------------------------------
+
+const (
+	// BlobGasPerBlob is the gas consumed by a single blob
+	BlobGasPerBlob uint64 = 1 << 17
+	// TargetBlobGasPerBlock is the target blob gas consumed per block
+	TargetBlobGasPerBlock uint64 = 3 * BlobGasPerBlob
+	// MinBlobBaseFee is the minimum price of a unit of blob gas
+	MinBlobBaseFee uint64 = 1
+	// BlobBaseFeeUpdateFraction controls the maximum rate of change of the blob base fee
+	BlobBaseFeeUpdateFraction uint64 = 3338477
+)
+
+// CalcExcessBlobGas calculates the excess blob gas of a block given the excess blob gas and
+// the blob gas used by its parent.
+func CalcExcessBlobGas(parentExcessBlobGas, parentBlobGasUsed uint64) uint64 {
+	excessBlobGas := parentExcessBlobGas + parentBlobGasUsed
+	if excessBlobGas < TargetBlobGasPerBlock {
+		return 0
+	}
+	return excessBlobGas - TargetBlobGasPerBlock
+}
+
+// BlobBaseFee calculates the blob base fee from the excess blob gas, as defined by EIP-4844:
+// https://eips.ethereum.org/EIPS/eip-4844#gas-accounting
+func BlobBaseFee(excessBlobGas uint64) *big.Int {
+	return fakeExponential(
+		new(big.Int).SetUint64(MinBlobBaseFee),
+		new(big.Int).SetUint64(excessBlobGas),
+		new(big.Int).SetUint64(BlobBaseFeeUpdateFraction),
+	)
+}
+
+// BlobBaseFee returns the blob base fee of the block at the given height, or nil if
+// the Cancun hard fork is not activated at that height.
+func (cc ChainConfig) BlobBaseFee(blockHeight *big.Int, excessBlobGas uint64) *big.Int {
+	if !isForked(getBlockValue(cc.CancunBlock), blockHeight) {
+		return nil
+	}
+	return BlobBaseFee(excessBlobGas)
+}
+
+// fakeExponential approximates factor * e ** (numerator / denominator) using
+// Taylor expansion.
+func fakeExponential(factor, numerator, denominator *big.Int) *big.Int {
+	var (
+		output = new(big.Int)
+		accum  = new(big.Int).Mul(factor, denominator)
+	)
+	for i := 1; accum.Sign() > 0; i++ {
+		output.Add(output, accum)
+
+		accum.Mul(accum, numerator)
+		accum.Div(accum, denominator)
+		accum.Div(accum, big.NewInt(int64(i)))
+	}
+	return output.Div(output, denominator)
+}
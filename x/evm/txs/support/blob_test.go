@@ -0,0 +1,80 @@
+package support
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcExcessBlobGas(t *testing.T) {
+	testCases := []struct {
+		parentExcess, parentUsed, expected uint64
+	}{
+		{0, 0, 0},
+		{0, TargetBlobGasPerBlock - BlobGasPerBlob, 0},
+		{0, TargetBlobGasPerBlock, 0},
+		{0, TargetBlobGasPerBlock + BlobGasPerBlob, BlobGasPerBlob},
+		{BlobGasPerBlob, TargetBlobGasPerBlock - BlobGasPerBlob, 0},
+		{2 * BlobGasPerBlob, TargetBlobGasPerBlock, 2 * BlobGasPerBlob},
+	}
+	for i, tc := range testCases {
+		require.Equal(t, tc.expected, CalcExcessBlobGas(tc.parentExcess, tc.parentUsed), "case %d", i)
+	}
+}
+
+func TestBlobBaseFee(t *testing.T) {
+	testCases := []struct {
+		excessBlobGas uint64
+		expected      int64
+	}{
+		{0, 1},
+		{2314057, 1},
+		{2314058, 2},
+		{10 * 1024 * 1024, 23},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.excessBlobGas), func(t *testing.T) {
+			require.Equal(t, big.NewInt(tc.expected), BlobBaseFee(tc.excessBlobGas))
+		})
+	}
+}
+
+func TestFakeExponential(t *testing.T) {
+	testCases := []struct {
+		factor, numerator, denominator, expected int64
+	}{
+		{1, 0, 1, 1},
+		{38493, 0, 1000, 38493},
+		{0, 1234, 2345, 0},
+		{1, 2, 1, 6},
+		{1, 4, 2, 6},
+		{1, 3, 1, 16},
+		{1, 6, 2, 18},
+		{1, 4, 1, 49},
+		{1, 8, 2, 50},
+		{10, 8, 2, 542},
+		{11, 8, 2, 596},
+		{1, 5, 1, 136},
+		{1, 5, 2, 11},
+		{2, 5, 2, 23},
+	}
+	for i, tc := range testCases {
+		res := fakeExponential(big.NewInt(tc.factor), big.NewInt(tc.numerator), big.NewInt(tc.denominator))
+		require.Equal(t, big.NewInt(tc.expected), res, "case %d", i)
+	}
+}
+
+func TestChainConfigBlobBaseFee(t *testing.T) {
+	cc := DefaultChainConfig()
+	cancunBlock := sdkmath.NewInt(10)
+	cc.CancunBlock = &cancunBlock
+
+	require.Nil(t, cc.BlobBaseFee(big.NewInt(9), 0))
+	require.Equal(t, big.NewInt(1), cc.BlobBaseFee(big.NewInt(10), 0))
+
+	cc.CancunBlock = nil
+	require.Nil(t, cc.BlobBaseFee(big.NewInt(100), 0))
+}
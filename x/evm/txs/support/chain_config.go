@@ -152,6 +152,85 @@ func (cc ChainConfig) Validate() error {
 	return nil
 }
 
+// EIPForkBlock returns the activation block of the hard fork including the given EIP,
+// and false if the EIP is not part of any of the hard forks of the ChainConfig.
+func (cc ChainConfig) EIPForkBlock(eip int) (*big.Int, bool) {
+	switch eip {
+	case 140, 196, 197, 198, 211, 214, 649, 658:
+		return getBlockValue(cc.ByzantiumBlock), true
+	case 145, 1014, 1052, 1234:
+		return getBlockValue(cc.ConstantinopleBlock), true
+	case 152, 1108, 1344, 1884, 2028, 2200:
+		return getBlockValue(cc.IstanbulBlock), true
+	case 2565, 2929, 2718, 2930:
+		return getBlockValue(cc.BerlinBlock), true
+	case 1559, 3198, 3529, 3541:
+		return getBlockValue(cc.LondonBlock), true
+	case 3651, 3855, 3860, 4895:
+		return getBlockValue(cc.ShanghaiBlock), true
+	case 1153, 4788, 4844, 5656, 6780, 7516:
+		return getBlockValue(cc.CancunBlock), true
+	default:
+		return nil, false
+	}
+}
+
+// forkBlock is a hard fork of the ChainConfig along with its activation block.
+type forkBlock struct {
+	name  string
+	block *big.Int
+}
+
+// forkBlocks returns the hard forks of the ChainConfig in activation order, the forks which
+// are not scheduled have a nil block.
+func (cc ChainConfig) forkBlocks() []forkBlock {
+	return []forkBlock{
+		{"homestead", getBlockValue(cc.HomesteadBlock)},
+		{"daoFork", getBlockValue(cc.DAOForkBlock)},
+		{"eip150", getBlockValue(cc.EIP150Block)},
+		{"eip155", getBlockValue(cc.EIP155Block)},
+		{"eip158", getBlockValue(cc.EIP158Block)},
+		{"byzantium", getBlockValue(cc.ByzantiumBlock)},
+		{"constantinople", getBlockValue(cc.ConstantinopleBlock)},
+		{"petersburg", getBlockValue(cc.PetersburgBlock)},
+		{"istanbul", getBlockValue(cc.IstanbulBlock)},
+		{"muirGlacier", getBlockValue(cc.MuirGlacierBlock)},
+		{"berlin", getBlockValue(cc.BerlinBlock)},
+		{"london", getBlockValue(cc.LondonBlock)},
+		{"arrowGlacier", getBlockValue(cc.ArrowGlacierBlock)},
+		{"grayGlacier", getBlockValue(cc.GrayGlacierBlock)},
+		{"mergeNetsplit", getBlockValue(cc.MergeNetsplitBlock)},
+		{"shanghai", getBlockValue(cc.ShanghaiBlock)},
+		{"cancun", getBlockValue(cc.CancunBlock)},
+	}
+}
+
+// ActiveForks returns the names of the hard forks activated at the given height, in
+// activation order, and the next hard fork scheduled after it along with its activation
+// block. The next fork is empty and its block nil if no fork is scheduled after the height.
+func (cc ChainConfig) ActiveForks(height *big.Int) (active []string, next string, nextBlock *big.Int) {
+	active = []string{}
+	for _, fork := range cc.forkBlocks() {
+		switch {
+		case fork.block == nil:
+			continue
+		case isForked(fork.block, height):
+			active = append(active, fork.name)
+		case nextBlock == nil || fork.block.Cmp(nextBlock) < 0:
+			next, nextBlock = fork.name, fork.block
+		}
+	}
+	return active, next, nextBlock
+}
+
+// isForked returns whether a fork scheduled at block s is active at the given head block.
+func isForked(s, head *big.Int) bool {
+	if s == nil || head == nil {
+		return false
+	}
+	return s.Cmp(head) <= 0
+}
+
 func getBlockValue(block *sdkmath.Int) *big.Int {
 	if block == nil || block.IsNegative() {
 		return nil
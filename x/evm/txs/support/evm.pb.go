@@ -41,6 +41,15 @@ type Params struct {
 	// allow_unprotected_txs defines if replay-protected (i.e non EIP155
 	// signed) transactions can be executed on the states machine.
 	AllowUnprotectedTxs bool `protobuf:"varint,6,opt,name=allow_unprotected_txs,json=allowUnprotectedTxs,proto3" json:"allow_unprotected_txs,omitempty"`
+	// max_init_code_size defines the maximum size in bytes of the contract init code
+	// (EIP-3860), zero falls back to the Ethereum limit
+	MaxInitCodeSize uint64 `protobuf:"varint,7,opt,name=max_init_code_size,json=maxInitCodeSize,proto3" json:"max_init_code_size,omitempty" yaml:"max_init_code_size"`
+	// block_gas_limit overrides the block gas limit used by the EVM, it can not exceed the
+	// consensus max gas, zero falls back to the consensus max gas
+	BlockGasLimit uint64 `protobuf:"varint,8,opt,name=block_gas_limit,json=blockGasLimit,proto3" json:"block_gas_limit,omitempty" yaml:"block_gas_limit"`
+	// blocked_opcodes defines the opcodes forbidden in the EVM, executing one of them aborts
+	// the execution with an invalid opcode error
+	BlockedOpcodes []int64 `protobuf:"varint,9,rep,packed,name=blocked_opcodes,json=blockedOpcodes,proto3" json:"blocked_opcodes,omitempty" yaml:"blocked_opcodes"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -118,6 +127,27 @@ func (m *Params) GetAllowUnprotectedTxs() bool {
 	return false
 }
 
+func (m *Params) GetMaxInitCodeSize() uint64 {
+	if m != nil {
+		return m.MaxInitCodeSize
+	}
+	return 0
+}
+
+func (m *Params) GetBlockGasLimit() uint64 {
+	if m != nil {
+		return m.BlockGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetBlockedOpcodes() []int64 {
+	if m != nil {
+		return m.BlockedOpcodes
+	}
+	return nil
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -463,6 +493,9 @@ type TxResult struct {
 	Reverted bool `protobuf:"varint,5,opt,name=reverted,proto3" json:"reverted,omitempty"`
 	// gas_used notes the amount of gas consumed while execution
 	GasUsed uint64 `protobuf:"varint,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// gas_refunded notes the amount of gas refunded to the sender, capped per
+	// EIP-3529. gas_used is net of this refund.
+	GasRefunded uint64 `protobuf:"varint,7,opt,name=gas_refunded,json=gasRefunded,proto3" json:"gas_refunded,omitempty"`
 }
 
 func (m *TxResult) Reset()         { *m = TxResult{} }
@@ -690,108 +723,112 @@ func init() {
 func init() { proto.RegisterFile("artela/evm/v1/evm.proto", fileDescriptor_c95fb7abfbae4d4d) }
 
 var fileDescriptor_c95fb7abfbae4d4d = []byte{
-	// 1605 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x6f, 0x23, 0xb7,
-	0x19, 0xb6, 0xad, 0xb1, 0x3d, 0xa2, 0xbe, 0xc6, 0xb4, 0xd6, 0xab, 0xec, 0x02, 0x1e, 0x63, 0x0e,
-	0x81, 0x0f, 0x59, 0x2b, 0x76, 0x60, 0x74, 0x91, 0xa2, 0x05, 0xac, 0x5d, 0x27, 0xb1, 0xbb, 0x49,
-	0x16, 0x5c, 0x07, 0x05, 0x72, 0x19, 0x50, 0x33, 0xcc, 0x68, 0xa2, 0x99, 0xa1, 0x40, 0x72, 0xb4,
-	0x52, 0xdb, 0x73, 0x91, 0x63, 0xff, 0x40, 0x8b, 0xfe, 0x9c, 0xa0, 0xa7, 0x1c, 0x8b, 0x1e, 0x06,
-	0x85, 0xf7, 0xe6, 0xa3, 0x7e, 0x41, 0xc1, 0x0f, 0x7d, 0xda, 0x08, 0x6a, 0x9d, 0x34, 0xcf, 0xfb,
-	0xf1, 0x3c, 0xe4, 0xcb, 0x97, 0x22, 0x09, 0x9e, 0x62, 0x26, 0x48, 0x82, 0xdb, 0x64, 0x98, 0xb6,
-	0x87, 0xa7, 0xf2, 0xe7, 0x64, 0xc0, 0xa8, 0xa0, 0xb0, 0xa6, 0x1d, 0x27, 0xd2, 0x32, 0x3c, 0x7d,
-	0xd6, 0x8c, 0x68, 0x44, 0x95, 0xa7, 0x2d, 0xbf, 0x74, 0x90, 0xf7, 0xd7, 0x12, 0xd8, 0x79, 0x8b,
-	0x19, 0x4e, 0x39, 0x3c, 0x05, 0x65, 0x32, 0x4c, 0xfd, 0x90, 0x64, 0x34, 0x6d, 0x6d, 0x1e, 0x6d,
-	0x1e, 0x97, 0x3b, 0xcd, 0x49, 0xe1, 0x3a, 0x63, 0x9c, 0x26, 0x9f, 0x7b, 0x33, 0x97, 0x87, 0x6c,
-	0x32, 0x4c, 0x5f, 0xcb, 0x4f, 0xf8, 0x3b, 0x50, 0x23, 0x19, 0xee, 0x26, 0xc4, 0x0f, 0x18, 0xc1,
-	0x82, 0xb4, 0xb6, 0x8e, 0x36, 0x8f, 0xed, 0x4e, 0x6b, 0x52, 0xb8, 0x4d, 0x93, 0xb6, 0xe8, 0xf6,
-	0x50, 0x55, 0xe3, 0x57, 0x0a, 0xc2, 0xdf, 0x80, 0xca, 0xd4, 0x8f, 0x93, 0xa4, 0x55, 0x52, 0xc9,
-	0x07, 0x93, 0xc2, 0x85, 0xcb, 0xc9, 0x38, 0x49, 0x3c, 0x04, 0x4c, 0x2a, 0x4e, 0x12, 0x78, 0x01,
-	0x00, 0x19, 0x09, 0x86, 0x7d, 0x12, 0x0f, 0x78, 0xcb, 0x3a, 0x2a, 0x1d, 0x97, 0x3a, 0xde, 0x6d,
-	0xe1, 0x96, 0x2f, 0xa5, 0xf5, 0xf2, 0xea, 0x2d, 0x9f, 0x14, 0xee, 0x9e, 0x21, 0x99, 0x05, 0x7a,
-	0xa8, 0xac, 0xc0, 0x65, 0x3c, 0xe0, 0xf0, 0x7b, 0x50, 0x0d, 0x7a, 0x38, 0xce, 0xfc, 0x80, 0x66,
-	0x3f, 0xc4, 0x51, 0x6b, 0xfb, 0x68, 0xf3, 0xb8, 0x72, 0xf6, 0xec, 0x64, 0xa9, 0x68, 0x27, 0xaf,
-	0x64, 0xc8, 0x2b, 0x15, 0xd1, 0x79, 0xfe, 0x73, 0xe1, 0x6e, 0x4c, 0x0a, 0x77, 0x5f, 0xf3, 0x2e,
-	0x66, 0x7b, 0xa8, 0x12, 0xcc, 0x23, 0xe1, 0x19, 0x78, 0x82, 0x93, 0x84, 0xbe, 0xf7, 0xf3, 0x4c,
-	0x56, 0x99, 0x04, 0x82, 0x84, 0xbe, 0x18, 0xf1, 0xd6, 0x8e, 0x9c, 0x21, 0xda, 0x57, 0xce, 0xef,
-	0xe6, 0xbe, 0x9b, 0x11, 0xf7, 0xfe, 0xb1, 0x07, 0x2a, 0x0b, 0x6a, 0x30, 0x05, 0x8d, 0x1e, 0x4d,
-	0x09, 0x17, 0x04, 0x87, 0x7e, 0x37, 0xa1, 0x41, 0xdf, 0xac, 0xc9, 0xeb, 0xff, 0x14, 0xee, 0xc7,
-	0x51, 0x2c, 0x7a, 0x79, 0xf7, 0x24, 0xa0, 0x69, 0x3b, 0xa0, 0x3c, 0xa5, 0xdc, 0xfc, 0xbc, 0xe0,
-	0x61, 0xbf, 0x2d, 0xc6, 0x03, 0xc2, 0x4f, 0xae, 0x32, 0x31, 0x29, 0xdc, 0x03, 0x3d, 0xd8, 0x15,
-	0x2a, 0x0f, 0xd5, 0x67, 0x96, 0x8e, 0x34, 0xc0, 0x31, 0xa8, 0x87, 0x98, 0xfa, 0x3f, 0x50, 0xd6,
-	0x37, 0x6a, 0x5b, 0x4a, 0xed, 0xdd, 0xff, 0xaf, 0x76, 0x5b, 0xb8, 0xd5, 0xd7, 0x17, 0xdf, 0x7e,
-	0x41, 0x59, 0x5f, 0x71, 0x4e, 0x0a, 0xf7, 0x89, 0x56, 0x5f, 0x66, 0xf6, 0x50, 0x35, 0xc4, 0x74,
-	0x16, 0x06, 0xff, 0x08, 0x9c, 0x59, 0x00, 0xcf, 0x07, 0x03, 0xca, 0x84, 0x69, 0x85, 0x17, 0xb7,
-	0x85, 0x5b, 0x37, 0x94, 0xef, 0xb4, 0x67, 0x52, 0xb8, 0x4f, 0x57, 0x48, 0x4d, 0x8e, 0x87, 0xea,
-	0x86, 0xd6, 0x84, 0x42, 0x0e, 0xaa, 0x24, 0x1e, 0x9c, 0x9e, 0x7f, 0x6a, 0x66, 0x64, 0xa9, 0x19,
-	0xbd, 0x7d, 0xd4, 0x8c, 0x2a, 0x97, 0x57, 0x6f, 0x4f, 0xcf, 0x3f, 0x9d, 0x4e, 0xc8, 0xac, 0xfd,
-	0x22, 0xad, 0x87, 0x2a, 0x1a, 0xea, 0xd9, 0x5c, 0x01, 0x03, 0xfd, 0x1e, 0xe6, 0x3d, 0xd5, 0x56,
-	0xe5, 0xce, 0xf1, 0x6d, 0xe1, 0x02, 0xcd, 0xf4, 0x15, 0xe6, 0xbd, 0xf9, 0xba, 0x74, 0xc7, 0x7f,
-	0xc2, 0x99, 0x88, 0xf3, 0x74, 0xca, 0x05, 0x74, 0xb2, 0x8c, 0x9a, 0x8d, 0xff, 0xdc, 0x8c, 0x7f,
-	0x67, 0xed, 0xf1, 0x9f, 0x3f, 0x34, 0xfe, 0xf3, 0xe5, 0xf1, 0xeb, 0x98, 0x99, 0xe8, 0x4b, 0x23,
-	0xba, 0xbb, 0xb6, 0xe8, 0xcb, 0x87, 0x44, 0x5f, 0x2e, 0x8b, 0xea, 0x18, 0xd9, 0xec, 0x2b, 0x95,
-	0x68, 0xd9, 0xeb, 0x37, 0xfb, 0xbd, 0xa2, 0xd6, 0x67, 0x16, 0x2d, 0xf7, 0x17, 0xd0, 0x0c, 0x68,
-	0xc6, 0x85, 0xb4, 0x65, 0x74, 0x90, 0x10, 0xa3, 0x59, 0x56, 0x9a, 0x57, 0x8f, 0xd2, 0x7c, 0x6e,
-	0xfe, 0x0d, 0x1e, 0xe0, 0xf3, 0xd0, 0xfe, 0xb2, 0x59, 0xab, 0x0f, 0x80, 0x33, 0x20, 0x82, 0x30,
-	0xde, 0xcd, 0x59, 0x64, 0x94, 0x81, 0x52, 0xbe, 0x7c, 0x94, 0xb2, 0xd9, 0x07, 0xab, 0x5c, 0x1e,
-	0x6a, 0xcc, 0x4d, 0x5a, 0xf1, 0x47, 0x50, 0x8f, 0xe5, 0x30, 0xba, 0x79, 0x62, 0xf4, 0x2a, 0x4a,
-	0xef, 0xd5, 0xa3, 0xf4, 0xcc, 0x66, 0x5e, 0x66, 0xf2, 0x50, 0x6d, 0x6a, 0xd0, 0x5a, 0x39, 0x80,
-	0x69, 0x1e, 0x33, 0x3f, 0x4a, 0x70, 0x10, 0x13, 0x66, 0xf4, 0xaa, 0x4a, 0xef, 0xcb, 0x47, 0xe9,
-	0x7d, 0xa4, 0xf5, 0xee, 0xb3, 0x79, 0xc8, 0x91, 0xc6, 0x2f, 0xb5, 0x4d, 0xcb, 0x86, 0xa0, 0xda,
-	0x25, 0x2c, 0x89, 0x33, 0x23, 0x58, 0x53, 0x82, 0x17, 0x8f, 0x12, 0x34, 0x7d, 0xba, 0xc8, 0xe3,
-	0xa1, 0x8a, 0x86, 0x33, 0x95, 0x84, 0x66, 0x21, 0x9d, 0xaa, 0xec, 0xad, 0xaf, 0xb2, 0xc8, 0xe3,
-	0xa1, 0x8a, 0x86, 0x5a, 0x65, 0x04, 0xf6, 0x31, 0x63, 0xf4, 0xfd, 0x4a, 0x0d, 0xa1, 0x12, 0xfb,
-	0xea, 0x51, 0x62, 0xcf, 0xb4, 0xd8, 0x03, 0x74, 0x1e, 0xda, 0x53, 0xd6, 0xa5, 0x2a, 0xe6, 0x00,
-	0x46, 0x0c, 0x8f, 0x57, 0x84, 0x9b, 0xeb, 0x2f, 0xde, 0x7d, 0x36, 0x0f, 0x39, 0xd2, 0xb8, 0x24,
-	0xfb, 0x67, 0xd0, 0x4c, 0x09, 0x8b, 0x88, 0x9f, 0x11, 0xc1, 0x07, 0x49, 0x2c, 0x8c, 0xf0, 0x93,
-	0xf5, 0xf7, 0xe3, 0x43, 0x7c, 0x1e, 0x82, 0xca, 0xfc, 0x8d, 0xb1, 0xce, 0x36, 0x07, 0xef, 0xe1,
-	0x2c, 0xea, 0xe1, 0xd8, 0xc8, 0x1e, 0xac, 0xbf, 0x39, 0x96, 0x99, 0x3c, 0x54, 0x9b, 0x1a, 0x66,
-	0xfd, 0x13, 0xe0, 0x2c, 0xc8, 0xa7, 0xfd, 0xf3, 0x74, 0xfd, 0xfe, 0x59, 0xe4, 0x91, 0xd7, 0x0f,
-	0x05, 0x95, 0xca, 0xb5, 0x65, 0xd7, 0x9d, 0xc6, 0xb5, 0x65, 0x37, 0x1c, 0xe7, 0xda, 0xb2, 0x1d,
-	0x67, 0xef, 0xda, 0xb2, 0xf7, 0x9d, 0x26, 0xaa, 0x8d, 0x69, 0x42, 0xfd, 0xe1, 0x67, 0x3a, 0x09,
-	0x55, 0xc8, 0x7b, 0xcc, 0xcd, 0x7f, 0x24, 0xaa, 0x07, 0x58, 0xe0, 0x64, 0xcc, 0x4d, 0xa9, 0x90,
-	0xa3, 0x0b, 0xb8, 0x70, 0x6a, 0xb7, 0xc1, 0xf6, 0x3b, 0x21, 0x6f, 0x6d, 0x0e, 0x28, 0xf5, 0xc9,
-	0x58, 0xdf, 0x46, 0x90, 0xfc, 0x84, 0x4d, 0xb0, 0x3d, 0xc4, 0x49, 0xae, 0xaf, 0x7f, 0x65, 0xa4,
-	0x81, 0xf7, 0x35, 0x68, 0xdc, 0x30, 0x9c, 0x71, 0x1c, 0x88, 0x98, 0x66, 0x6f, 0x68, 0xc4, 0x21,
-	0x04, 0x96, 0x3a, 0x15, 0x75, 0xae, 0xfa, 0x86, 0x1f, 0x03, 0x2b, 0xa1, 0x11, 0x6f, 0x6d, 0x1d,
-	0x95, 0x8e, 0x2b, 0x67, 0x70, 0xe5, 0x02, 0xf6, 0x86, 0x46, 0x48, 0xf9, 0xbd, 0x7f, 0x6d, 0x81,
-	0xd2, 0x1b, 0x1a, 0xc1, 0x16, 0xd8, 0xc5, 0x61, 0xc8, 0x08, 0xe7, 0x86, 0x66, 0x0a, 0xe1, 0x01,
-	0xd8, 0x11, 0x74, 0x10, 0x07, 0x9a, 0xab, 0x8c, 0x0c, 0x92, 0xaa, 0x21, 0x16, 0x58, 0x5d, 0x2a,
-	0xaa, 0x48, 0x7d, 0xc3, 0x33, 0x50, 0x55, 0xd3, 0xf2, 0xb3, 0x3c, 0xed, 0x12, 0xa6, 0xee, 0x06,
-	0x56, 0xa7, 0x71, 0x57, 0xb8, 0x15, 0x65, 0xff, 0x46, 0x99, 0xd1, 0x22, 0x80, 0x9f, 0x80, 0x5d,
-	0x31, 0x5a, 0x3c, 0xd6, 0xf7, 0xef, 0x0a, 0xb7, 0x21, 0xe6, 0x73, 0x94, 0xa7, 0x36, 0xda, 0x11,
-	0x23, 0x75, 0x7a, 0xb7, 0x81, 0x2d, 0x46, 0x7e, 0x9c, 0x85, 0x64, 0xa4, 0x4e, 0x6e, 0xab, 0xd3,
-	0xbc, 0x2b, 0x5c, 0x67, 0x21, 0xfc, 0x4a, 0xfa, 0xd0, 0xae, 0x18, 0xa9, 0x0f, 0xf8, 0x09, 0x00,
-	0x7a, 0x48, 0x4a, 0x41, 0x9f, 0xbb, 0xb5, 0xbb, 0xc2, 0x2d, 0x2b, 0xab, 0xe2, 0x9e, 0x7f, 0x42,
-	0x0f, 0x6c, 0x6b, 0x6e, 0x5b, 0x71, 0x57, 0xef, 0x0a, 0xd7, 0x4e, 0x68, 0xa4, 0x39, 0xb5, 0x4b,
-	0x96, 0x8a, 0x91, 0x94, 0x0e, 0x49, 0xa8, 0x8e, 0x36, 0x1b, 0x4d, 0xa1, 0xf7, 0xd3, 0x16, 0xb0,
-	0x6f, 0x46, 0x88, 0xf0, 0x3c, 0x11, 0xf0, 0x0b, 0xe0, 0x04, 0x34, 0x13, 0x0c, 0x07, 0xc2, 0x5f,
-	0x2a, 0x6d, 0xe7, 0xf9, 0xfc, 0x98, 0x59, 0x8d, 0xf0, 0x50, 0x63, 0x6a, 0xba, 0x30, 0xf5, 0x6f,
-	0x82, 0xed, 0x6e, 0x42, 0x69, 0xaa, 0xda, 0xa0, 0x8a, 0x34, 0x80, 0xdf, 0xaa, 0xaa, 0xa9, 0x25,
-	0x2e, 0xa9, 0x3b, 0xf6, 0xe1, 0xca, 0x12, 0xaf, 0x34, 0x49, 0xe7, 0xc0, 0xdc, 0xb3, 0xeb, 0x5a,
-	0xd8, 0x24, 0x7b, 0xb2, 0xb0, 0xaa, 0x89, 0x1c, 0x50, 0x62, 0x44, 0xa8, 0x15, 0xab, 0x22, 0xf9,
-	0x09, 0x9f, 0x01, 0x9b, 0x91, 0x21, 0x61, 0x82, 0x84, 0x6a, 0x65, 0x6c, 0x34, 0xc3, 0xf0, 0x23,
-	0x60, 0x47, 0x98, 0xfb, 0x39, 0x27, 0xa1, 0x5e, 0x06, 0xb4, 0x1b, 0x61, 0xfe, 0x1d, 0x27, 0xe1,
-	0xe7, 0xd6, 0x4f, 0xff, 0x74, 0x37, 0x3c, 0x0c, 0x2a, 0x17, 0x41, 0x40, 0x38, 0xbf, 0xc9, 0x07,
-	0x09, 0xf9, 0x95, 0xf6, 0x3a, 0x03, 0x55, 0x2e, 0x28, 0xc3, 0x11, 0xf1, 0xfb, 0x64, 0x6c, 0x9a,
-	0x4c, 0xb7, 0x8c, 0xb1, 0xff, 0x81, 0x8c, 0x39, 0x5a, 0x04, 0x46, 0xe2, 0xef, 0x16, 0xa8, 0xdc,
-	0x30, 0x1c, 0x10, 0x73, 0xb7, 0x97, 0x8d, 0x2a, 0x21, 0x33, 0x12, 0x06, 0x49, 0x6d, 0x11, 0xa7,
-	0x84, 0xe6, 0xc2, 0xec, 0xa4, 0x29, 0x94, 0x19, 0x8c, 0x90, 0x11, 0x09, 0x54, 0x0d, 0x2d, 0x64,
-	0x10, 0x3c, 0x07, 0xb5, 0x30, 0xe6, 0xea, 0x95, 0xc4, 0x05, 0x0e, 0xfa, 0x7a, 0xfa, 0x1d, 0xe7,
-	0xae, 0x70, 0xab, 0xc6, 0xf1, 0x4e, 0xda, 0xd1, 0x12, 0x82, 0xbf, 0x05, 0x8d, 0x79, 0x9a, 0x1a,
-	0xad, 0x7e, 0x9a, 0x74, 0xe0, 0x5d, 0xe1, 0xd6, 0x67, 0xa1, 0xca, 0x83, 0x56, 0xb0, 0x5c, 0xe6,
-	0x90, 0x74, 0xf3, 0x48, 0x75, 0x9e, 0x8d, 0x34, 0x90, 0xd6, 0x24, 0x4e, 0x63, 0xa1, 0x3a, 0x6d,
-	0x1b, 0x69, 0x00, 0x5f, 0x82, 0x32, 0x1d, 0x12, 0xc6, 0xe2, 0x90, 0x70, 0x75, 0xc9, 0xf9, 0xd5,
-	0x27, 0x16, 0x9a, 0x07, 0xcb, 0x99, 0x99, 0xe7, 0x5f, 0x4a, 0x52, 0xca, 0xc6, 0xea, 0xca, 0x62,
-	0x66, 0xa6, 0x1d, 0x5f, 0x2b, 0x3b, 0x5a, 0x42, 0xb0, 0x03, 0xa0, 0x49, 0x63, 0x44, 0xe4, 0x2c,
-	0xf3, 0xd5, 0xce, 0xaf, 0xaa, 0x5c, 0xb5, 0xff, 0xb4, 0x17, 0x29, 0xe7, 0x6b, 0x2c, 0x30, 0xba,
-	0x67, 0x81, 0xbf, 0x07, 0x50, 0x2f, 0x88, 0xff, 0x23, 0xa7, 0xb3, 0x07, 0xa2, 0xbe, 0x51, 0x28,
-	0x7d, 0xed, 0x35, 0x63, 0x76, 0x34, 0xba, 0xe6, 0xd4, 0xcc, 0xe2, 0xda, 0xb2, 0x2d, 0x67, 0xfb,
-	0xda, 0xb2, 0x77, 0x1d, 0x7b, 0x56, 0x3c, 0x33, 0x0b, 0xb4, 0x3f, 0xc5, 0x0b, 0xc3, 0xeb, 0x5c,
-	0xfd, 0x7c, 0x7b, 0xb8, 0xf9, 0xcb, 0xed, 0xe1, 0xe6, 0x7f, 0x6f, 0x0f, 0x37, 0xff, 0xf6, 0xe1,
-	0x70, 0xe3, 0x97, 0x0f, 0x87, 0x1b, 0xff, 0xfe, 0x70, 0xb8, 0xf1, 0x7d, 0x7b, 0xe1, 0x58, 0xd0,
-	0x65, 0x7b, 0x91, 0x11, 0xf1, 0x9e, 0xb2, 0xbe, 0x81, 0xf2, 0xc9, 0x3f, 0x52, 0x6f, 0x7f, 0x75,
-	0x46, 0x74, 0x77, 0xd4, 0xb3, 0xfe, 0xb3, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xa7, 0x6e, 0xd7,
-	0xa7, 0x16, 0x10, 0x00, 0x00,
+	// 1666 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0x9d, 0x58, 0xcd, 0x6e, 0x1b, 0x37,
+	0x10, 0x4e, 0x6c, 0xd9, 0x96, 0x28, 0x59, 0x5a, 0xd3, 0x8a, 0xa3, 0x38, 0x68, 0x9c, 0xee, 0x21,
+	0xc8, 0xa1, 0xb1, 0xea, 0x04, 0x46, 0x8d, 0x14, 0x2d, 0x60, 0xd9, 0x4e, 0xe2, 0x34, 0x7f, 0xa0,
+	0x1d, 0x14, 0xc8, 0x65, 0x41, 0xed, 0x32, 0xf2, 0xc6, 0xfb, 0x23, 0x2c, 0x77, 0x1d, 0x29, 0xed,
+	0x03, 0xf4, 0xd8, 0x17, 0x68, 0xd1, 0x7b, 0x5f, 0x24, 0xe8, 0x29, 0xc7, 0xa2, 0x05, 0x82, 0x22,
+	0xbd, 0xf5, 0xd8, 0x27, 0xe8, 0x70, 0x48, 0x49, 0x2b, 0xd9, 0x68, 0x6b, 0x1d, 0x24, 0xed, 0xfc,
+	0x7d, 0x1f, 0x39, 0x3b, 0xe4, 0x90, 0x22, 0x97, 0x79, 0x92, 0x8a, 0x80, 0x37, 0xc5, 0x49, 0xd8,
+	0x3c, 0xd9, 0x50, 0x3f, 0xeb, 0xdd, 0x24, 0x4e, 0x63, 0xba, 0xa8, 0x0d, 0xeb, 0x4a, 0x73, 0xb2,
+	0xb1, 0x5a, 0xef, 0xc4, 0x9d, 0x18, 0x2d, 0x4d, 0xf5, 0xa4, 0x9d, 0xec, 0xdf, 0x0b, 0x64, 0xfe,
+	0x19, 0x4f, 0x78, 0x28, 0xe9, 0x06, 0x29, 0x81, 0xab, 0xe3, 0x89, 0x28, 0x0e, 0x1b, 0x17, 0xaf,
+	0x5f, 0xbc, 0x59, 0x6a, 0xd5, 0xff, 0x7e, 0xbf, 0x66, 0xf5, 0x79, 0x18, 0xdc, 0xb5, 0x87, 0x26,
+	0x9b, 0x15, 0xe1, 0x79, 0x57, 0x3d, 0xd2, 0x2f, 0xc8, 0xa2, 0x88, 0x78, 0x3b, 0x10, 0x8e, 0x9b,
+	0x08, 0x9e, 0x8a, 0xc6, 0x0c, 0x84, 0x15, 0x5b, 0x0d, 0x08, 0xab, 0x9b, 0xb0, 0xbc, 0xd9, 0x66,
+	0x15, 0x2d, 0xef, 0xa0, 0x48, 0x3f, 0x23, 0xe5, 0x81, 0x9d, 0x07, 0x41, 0x63, 0x16, 0x83, 0x57,
+	0x20, 0x98, 0x8e, 0x07, 0x83, 0xd1, 0x66, 0xc4, 0x84, 0x82, 0x40, 0xb7, 0x09, 0x11, 0xbd, 0x34,
+	0xe1, 0x8e, 0xf0, 0xbb, 0xb2, 0x51, 0xb8, 0x3e, 0x7b, 0x73, 0xb6, 0x65, 0x7f, 0x78, 0xbf, 0x56,
+	0xda, 0x53, 0xda, 0xbd, 0xfd, 0x67, 0x12, 0x40, 0x96, 0x0c, 0xc8, 0xd0, 0xd1, 0x66, 0x25, 0x14,
+	0xf6, 0xe0, 0x99, 0xbe, 0x20, 0x15, 0xf7, 0x88, 0xfb, 0x91, 0xe3, 0xc6, 0xd1, 0x4b, 0xbf, 0xd3,
+	0x98, 0x03, 0xf2, 0xf2, 0xed, 0xd5, 0xf5, 0xb1, 0xa4, 0xad, 0xef, 0x28, 0x97, 0x1d, 0xf4, 0x68,
+	0x5d, 0x7d, 0xfb, 0x7e, 0xed, 0x02, 0xe0, 0x2e, 0x6b, 0xdc, 0x7c, 0xb4, 0xcd, 0xca, 0xee, 0xc8,
+	0x93, 0xde, 0x26, 0x97, 0x60, 0x94, 0xf1, 0x6b, 0x27, 0x8b, 0x54, 0x96, 0x85, 0x9b, 0x0a, 0xcf,
+	0x49, 0x7b, 0xb2, 0x31, 0xaf, 0x66, 0xc8, 0x96, 0xd1, 0xf8, 0x7c, 0x64, 0x3b, 0xec, 0x49, 0xfa,
+	0x90, 0xd0, 0x90, 0xf7, 0x1c, 0x3f, 0xf2, 0x53, 0x00, 0xf5, 0x84, 0x23, 0xfd, 0x37, 0xa2, 0xb1,
+	0x00, 0x01, 0x85, 0xd6, 0x47, 0xc0, 0x7a, 0x45, 0xb3, 0x9e, 0xf6, 0xb1, 0x59, 0x0d, 0x94, 0xfb,
+	0xa0, 0xdb, 0x01, 0xd5, 0x01, 0x68, 0x68, 0x8b, 0xd4, 0xda, 0x41, 0xec, 0x1e, 0x3b, 0x1d, 0x2e,
+	0x9d, 0xc0, 0x0f, 0xfd, 0xb4, 0x51, 0x44, 0xa0, 0x55, 0x00, 0x5a, 0xd1, 0x40, 0x13, 0x0e, 0x36,
+	0x5b, 0x44, 0xcd, 0x7d, 0x2e, 0x1f, 0x29, 0x99, 0xee, 0x18, 0x0c, 0x18, 0x79, 0xdc, 0x55, 0x64,
+	0xb2, 0x51, 0xc2, 0x3c, 0x4f, 0x62, 0x8c, 0x1c, 0x6c, 0x56, 0x35, 0x9a, 0xa7, 0x46, 0xf1, 0xe3,
+	0x12, 0x29, 0xe7, 0x52, 0x48, 0x43, 0x52, 0x3b, 0x8a, 0x43, 0x21, 0x53, 0xc1, 0x3d, 0x07, 0x7d,
+	0x4d, 0xa1, 0xed, 0xfe, 0xf6, 0x7e, 0xed, 0x46, 0xc7, 0x4f, 0x8f, 0xb2, 0xf6, 0xba, 0x1b, 0x87,
+	0x4d, 0x37, 0x96, 0x61, 0x2c, 0xcd, 0xcf, 0x2d, 0xe9, 0x1d, 0x37, 0xd3, 0x7e, 0x57, 0xc8, 0xf5,
+	0xfd, 0x28, 0x1d, 0xd1, 0x4f, 0x40, 0x01, 0xfd, 0x50, 0xd3, 0x52, 0x0a, 0xda, 0x27, 0x55, 0x8f,
+	0xc7, 0xce, 0xcb, 0x38, 0x39, 0x36, 0x6c, 0x33, 0xc8, 0x76, 0xf0, 0xff, 0xd9, 0xa0, 0xa8, 0x2a,
+	0xbb, 0xdb, 0x4f, 0xef, 0x01, 0x04, 0x62, 0x02, 0xfb, 0x25, 0xcd, 0x3e, 0x8e, 0x0c, 0xa5, 0x0d,
+	0x8a, 0xa1, 0x1b, 0xfd, 0x9a, 0x58, 0x43, 0x07, 0x99, 0x75, 0xbb, 0x71, 0x92, 0x9a, 0xfa, 0xbe,
+	0x05, 0x90, 0x55, 0x03, 0x79, 0xa0, 0x2d, 0x00, 0x7a, 0x79, 0x02, 0xd4, 0xc4, 0xc0, 0x9c, 0x0c,
+	0xac, 0x71, 0xa5, 0x92, 0x54, 0xa0, 0x96, 0x37, 0x36, 0x3f, 0x35, 0x33, 0x2a, 0xe0, 0x8c, 0x9e,
+	0x9d, 0x6b, 0x46, 0x65, 0x58, 0x21, 0x80, 0x30, 0x98, 0x90, 0x29, 0xe8, 0x3c, 0x2c, 0x14, 0xb4,
+	0x16, 0xf5, 0x6c, 0xf6, 0x89, 0x11, 0x9d, 0x23, 0x2e, 0x8f, 0x70, 0xad, 0x94, 0x5a, 0x37, 0x01,
+	0x89, 0x68, 0xa4, 0x07, 0xa0, 0xcd, 0x95, 0x45, 0xff, 0x0d, 0x8f, 0x52, 0x3f, 0x0b, 0x07, 0x58,
+	0x44, 0x07, 0x2b, 0xaf, 0xe1, 0xf8, 0x37, 0xcd, 0xf8, 0xe7, 0xa7, 0x1e, 0xff, 0xe6, 0x59, 0xe3,
+	0xdf, 0x1c, 0x1f, 0xbf, 0xf6, 0x19, 0x92, 0x6e, 0x19, 0xd2, 0x85, 0xa9, 0x49, 0xb7, 0xce, 0x22,
+	0xdd, 0x1a, 0x27, 0xd5, 0x3e, 0xaa, 0xd8, 0x27, 0x32, 0x81, 0xab, 0x70, 0xca, 0x62, 0x3f, 0x95,
+	0xd4, 0xea, 0x50, 0xa3, 0xe9, 0xbe, 0x25, 0x75, 0xd8, 0x8c, 0x64, 0xaa, 0x74, 0x51, 0xdc, 0x85,
+	0x7d, 0x53, 0x73, 0x96, 0x90, 0x73, 0xff, 0x5c, 0x9c, 0x57, 0xcd, 0x16, 0x77, 0x06, 0x9e, 0xcd,
+	0x96, 0xc7, 0xd5, 0x9a, 0xbd, 0x4b, 0xac, 0xae, 0x48, 0x45, 0x22, 0xdb, 0x59, 0xd2, 0x31, 0xcc,
+	0x04, 0x99, 0xf7, 0xce, 0xc5, 0x6c, 0xd6, 0xc1, 0x24, 0x16, 0x6c, 0x72, 0x23, 0x95, 0x66, 0x7c,
+	0x45, 0xaa, 0xbe, 0x1a, 0x46, 0x3b, 0x0b, 0x0c, 0x5f, 0x19, 0xf9, 0x76, 0xce, 0xc5, 0x67, 0x16,
+	0xf3, 0x38, 0x12, 0x6c, 0x86, 0x03, 0x85, 0xe6, 0xca, 0x60, 0x73, 0xce, 0xfc, 0xc4, 0xe9, 0x04,
+	0xdc, 0xf5, 0x45, 0x62, 0xf8, 0x2a, 0xc8, 0x77, 0xff, 0x5c, 0x7c, 0x83, 0x6d, 0xfc, 0x14, 0x9a,
+	0xcd, 0x2c, 0xa5, 0xbc, 0xaf, 0x75, 0x9a, 0xd6, 0x23, 0x95, 0xb6, 0x48, 0x02, 0x68, 0x33, 0x9a,
+	0x70, 0x11, 0x09, 0xb7, 0xcf, 0x45, 0x68, 0xea, 0x34, 0x8f, 0x03, 0x75, 0xaa, 0xc5, 0x21, 0x4b,
+	0x10, 0x47, 0x5e, 0x3c, 0x60, 0x59, 0x9a, 0x9e, 0x25, 0x8f, 0x03, 0x2c, 0x5a, 0xd4, 0x2c, 0x3d,
+	0xb2, 0xcc, 0x93, 0x04, 0x7a, 0xe2, 0x78, 0x0e, 0x29, 0x92, 0x3d, 0x38, 0x17, 0xd9, 0xaa, 0x26,
+	0x3b, 0x03, 0xce, 0x66, 0x4b, 0xa8, 0x1d, 0xcb, 0x22, 0xbc, 0xbc, 0x4e, 0xc2, 0xfb, 0x13, 0xc4,
+	0xf5, 0xe9, 0x5f, 0xde, 0x69, 0x34, 0x78, 0x79, 0x4a, 0x39, 0x46, 0xfb, 0x0d, 0xa9, 0x87, 0x22,
+	0xe9, 0x08, 0x27, 0x12, 0xa9, 0xec, 0x06, 0xd0, 0xb2, 0x35, 0xf1, 0xa5, 0xe9, 0xd7, 0xe3, 0x59,
+	0x78, 0x36, 0xa3, 0xa8, 0x7e, 0x62, 0xb4, 0xc3, 0xc5, 0x21, 0x8f, 0x78, 0xd4, 0x81, 0xde, 0x6b,
+	0x68, 0x57, 0xa6, 0x5f, 0x1c, 0xe3, 0x48, 0xb0, 0x38, 0x06, 0x8a, 0x61, 0xfd, 0xb8, 0x3c, 0x72,
+	0xb3, 0x41, 0xfd, 0x5c, 0x9e, 0xbe, 0x7e, 0xf2, 0x38, 0xea, 0x4c, 0x85, 0x22, 0xb2, 0x3c, 0x2c,
+	0x14, 0xab, 0x56, 0x0d, 0xbe, 0x6b, 0x96, 0x05, 0xdf, 0x96, 0xb5, 0x04, 0xdf, 0xcb, 0x56, 0x9d,
+	0x2d, 0xf6, 0xe3, 0x20, 0x76, 0x4e, 0xee, 0xe8, 0x20, 0xd8, 0x81, 0x5f, 0x73, 0x69, 0xf6, 0x48,
+	0x56, 0x75, 0x79, 0xca, 0x83, 0xbe, 0x34, 0xa9, 0x82, 0x15, 0x86, 0x09, 0xcc, 0x75, 0xed, 0x26,
+	0x99, 0x3b, 0x48, 0xd5, 0x51, 0xd4, 0x22, 0xb3, 0xc7, 0xa2, 0xaf, 0x4f, 0x23, 0x4c, 0x3d, 0xd2,
+	0x3a, 0x99, 0x3b, 0xe1, 0x41, 0xa6, 0xcf, 0xb4, 0x25, 0xa6, 0x05, 0xfb, 0x31, 0xa9, 0x1d, 0x26,
+	0x3c, 0x92, 0xdc, 0x4d, 0xfd, 0x38, 0x7a, 0x14, 0x77, 0x24, 0xa5, 0xa4, 0x80, 0x5d, 0x51, 0xc7,
+	0xe2, 0x33, 0xbd, 0x41, 0x0a, 0x01, 0xd8, 0x20, 0x76, 0x16, 0x4e, 0x95, 0x74, 0xe2, 0x54, 0x09,
+	0x61, 0x0c, 0xed, 0xf6, 0x2f, 0x33, 0x64, 0x16, 0x24, 0xda, 0x20, 0x0b, 0xdc, 0xf3, 0x12, 0x21,
+	0xa5, 0x81, 0x19, 0x88, 0x74, 0x85, 0xcc, 0xa7, 0x71, 0xd7, 0x77, 0x35, 0x56, 0x89, 0x19, 0x49,
+	0xb1, 0x7a, 0x30, 0x3b, 0x3c, 0x54, 0x54, 0x18, 0x3e, 0xc3, 0xb9, 0xb3, 0xa2, 0x8f, 0x75, 0x51,
+	0x16, 0xc2, 0x0a, 0xc7, 0xb3, 0x41, 0xa1, 0x55, 0xfb, 0x0b, 0x9a, 0x17, 0xea, 0x9f, 0xa0, 0x9a,
+	0xe5, 0x05, 0xfa, 0x09, 0x59, 0x48, 0x7b, 0xf9, 0xb6, 0xbe, 0x0c, 0xee, 0xb5, 0x74, 0x34, 0x47,
+	0xd5, 0xb5, 0x81, 0xb5, 0x87, 0xdd, 0xbb, 0x49, 0x8a, 0xa9, 0x3a, 0x80, 0x7a, 0xa2, 0x87, 0x9d,
+	0xbb, 0xd0, 0xaa, 0x83, 0xbb, 0x95, 0x73, 0xdf, 0x57, 0x36, 0x06, 0x98, 0xf8, 0x00, 0xf0, 0x44,
+	0x0f, 0x09, 0x19, 0x74, 0xdf, 0x5d, 0x84, 0x90, 0x12, 0x6a, 0x11, 0x7b, 0xf4, 0x48, 0x6d, 0x32,
+	0xa7, 0xb1, 0xf5, 0x71, 0xb5, 0x02, 0x8e, 0x45, 0xc8, 0x93, 0xc6, 0xd4, 0x26, 0x95, 0xaa, 0x44,
+	0x84, 0xf1, 0x89, 0xf0, 0xb0, 0xb5, 0x15, 0xd9, 0x40, 0xb4, 0x7f, 0x9e, 0x21, 0xc5, 0xc3, 0x1e,
+	0x13, 0x32, 0x0b, 0x52, 0x7a, 0x8f, 0x58, 0xd0, 0xa7, 0x60, 0x60, 0x6e, 0xea, 0x8c, 0xa5, 0xb6,
+	0x75, 0x75, 0xd4, 0x66, 0x26, 0x3d, 0xa0, 0xcd, 0x0c, 0x54, 0xdb, 0x26, 0xff, 0x50, 0x06, 0x30,
+	0x3e, 0xb8, 0x11, 0xcd, 0x60, 0xa2, 0xb5, 0x40, 0x9f, 0x62, 0xd6, 0xf0, 0x15, 0xcf, 0xe2, 0xc5,
+	0xe1, 0xda, 0xc4, 0x2b, 0x9e, 0x28, 0x92, 0xd6, 0x8a, 0xb9, 0x3c, 0x54, 0x35, 0xb1, 0x09, 0xb6,
+	0x55, 0x62, 0xb1, 0x88, 0xa0, 0xfe, 0x12, 0x91, 0xe2, 0x1b, 0xab, 0x30, 0xf5, 0x48, 0x57, 0x49,
+	0x31, 0x11, 0x27, 0x02, 0x50, 0x3d, 0x7c, 0x33, 0x45, 0x36, 0x94, 0xe9, 0x15, 0x52, 0x54, 0x27,
+	0xf7, 0x4c, 0x82, 0x0d, 0x5f, 0x03, 0x5b, 0x00, 0xf9, 0x39, 0x88, 0xf4, 0x63, 0x52, 0x51, 0xa6,
+	0x44, 0xbc, 0xcc, 0x20, 0x5d, 0x9e, 0xbe, 0x41, 0xb0, 0x32, 0xe8, 0x98, 0x51, 0xdd, 0x2d, 0x7c,
+	0xf7, 0xd3, 0xda, 0x05, 0x9b, 0x93, 0xf2, 0xb6, 0xeb, 0xc2, 0x14, 0x0f, 0x33, 0xe8, 0xe2, 0xff,
+	0x52, 0x81, 0x50, 0x55, 0x32, 0x8d, 0x13, 0x0e, 0x2b, 0x07, 0xd6, 0x85, 0xa9, 0x43, 0x5d, 0x55,
+	0x46, 0xff, 0x15, 0xa8, 0x59, 0x5e, 0x30, 0x14, 0x3f, 0x14, 0x48, 0x19, 0x12, 0xe1, 0x0a, 0x73,
+	0xfc, 0x57, 0xb5, 0xac, 0xc4, 0xc4, 0x50, 0x18, 0x49, 0x71, 0xa7, 0x7e, 0x28, 0xe2, 0x2c, 0x35,
+	0x8b, 0x6d, 0x20, 0xaa, 0x88, 0x44, 0x88, 0x9e, 0x70, 0x31, 0xcd, 0x05, 0x66, 0x24, 0xba, 0x49,
+	0x16, 0x3d, 0x5f, 0xe2, 0xed, 0x10, 0x1a, 0x35, 0x6c, 0x3a, 0x98, 0xa1, 0x96, 0x05, 0x83, 0xaa,
+	0x18, 0xc3, 0x81, 0xd2, 0xb3, 0x31, 0x89, 0x7e, 0x4e, 0x6a, 0xa3, 0x30, 0x1c, 0xad, 0xbe, 0x92,
+	0xb5, 0x28, 0x04, 0x56, 0x87, 0xae, 0x68, 0x61, 0x13, 0xb2, 0xaa, 0x04, 0x4f, 0xb4, 0xb3, 0x0e,
+	0x16, 0x67, 0x91, 0x69, 0x41, 0x69, 0xf5, 0x0d, 0x4b, 0x15, 0xe3, 0x1c, 0xd3, 0x02, 0xdd, 0x22,
+	0x25, 0x28, 0xc9, 0x24, 0xf1, 0xd5, 0xbd, 0x89, 0xfc, 0xd7, 0xd5, 0x92, 0x8d, 0x9c, 0xd5, 0xcc,
+	0xcc, 0xb5, 0x37, 0x84, 0xb2, 0x4e, 0xfa, 0x78, 0xaa, 0x31, 0x33, 0xd3, 0x86, 0xc7, 0xa8, 0x67,
+	0x63, 0x12, 0x5c, 0xf9, 0xa8, 0x09, 0x83, 0xda, 0xc9, 0x92, 0xc8, 0xc1, 0xcd, 0xa1, 0x82, 0xb1,
+	0xb8, 0x44, 0xb5, 0x95, 0xa1, 0x71, 0x17, 0x6c, 0xec, 0x94, 0x86, 0x7e, 0x49, 0xa8, 0x7e, 0x21,
+	0xce, 0x2b, 0x19, 0x0f, 0x2f, 0xc6, 0xfa, 0xd0, 0x81, 0xfc, 0xda, 0x6a, 0xc6, 0x6c, 0x69, 0xe9,
+	0x21, 0xb8, 0x6a, 0x0d, 0x6c, 0xc8, 0x05, 0x6b, 0x0e, 0xbe, 0x17, 0xac, 0xe2, 0x30, 0x79, 0x66,
+	0x16, 0x6c, 0x79, 0x20, 0xe7, 0x86, 0xd7, 0xda, 0x7f, 0xfb, 0xe1, 0xda, 0xc5, 0x77, 0xf0, 0xf9,
+	0x03, 0x3e, 0xdf, 0xff, 0x79, 0xed, 0xc2, 0x3b, 0xf8, 0xfc, 0x0a, 0x9f, 0x17, 0xcd, 0x5c, 0xe7,
+	0xd0, 0x69, 0xbb, 0x05, 0x6d, 0xef, 0x35, 0x6c, 0xdc, 0x46, 0x54, 0x7f, 0x75, 0xf4, 0xf0, 0x3f,
+	0x0f, 0x6c, 0x23, 0xed, 0x79, 0xfc, 0x3b, 0xe3, 0xce, 0x3f, 0xa0, 0x33, 0xa2, 0x0d, 0x0e, 0x11,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -814,6 +851,35 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockedOpcodes) > 0 {
+		dAtA5 := make([]byte, len(m.BlockedOpcodes)*10)
+		var j4 int
+		for _, num1 := range m.BlockedOpcodes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintEvm(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.BlockGasLimit != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BlockGasLimit))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxInitCodeSize != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxInitCodeSize))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.AllowUnprotectedTxs {
 		i--
 		if m.AllowUnprotectedTxs {
@@ -1325,6 +1391,11 @@ func (m *TxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasRefunded != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.GasRefunded))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.GasUsed != 0 {
 		i = encodeVarintEvm(dAtA, i, uint64(m.GasUsed))
 		i--
@@ -1568,6 +1639,19 @@ func (m *Params) Size() (n int) {
 	if m.AllowUnprotectedTxs {
 		n += 2
 	}
+	if m.MaxInitCodeSize != 0 {
+		n += 1 + sovEvm(uint64(m.MaxInitCodeSize))
+	}
+	if m.BlockGasLimit != 0 {
+		n += 1 + sovEvm(uint64(m.BlockGasLimit))
+	}
+	if len(m.BlockedOpcodes) > 0 {
+		l = 0
+		for _, e := range m.BlockedOpcodes {
+			l += sovEvm(uint64(e))
+		}
+		n += 1 + sovEvm(uint64(l)) + l
+	}
 	return n
 }
 
@@ -1760,6 +1844,9 @@ func (m *TxResult) Size() (n int) {
 	if m.GasUsed != 0 {
 		n += 1 + sovEvm(uint64(m.GasUsed))
 	}
+	if m.GasRefunded != 0 {
+		n += 1 + sovEvm(uint64(m.GasRefunded))
+	}
 	return n
 }
 
@@ -1952,6 +2039,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
+				if len(m.ExtraEIPs) >= MaxExtraEIPs {
+					return ErrInvalidLengthEvm
+				}
 				m.ExtraEIPs = append(m.ExtraEIPs, v)
 			} else if wireType == 2 {
 				var packedLen int
@@ -1987,6 +2077,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
+				if elementCount > MaxExtraEIPs-len(m.ExtraEIPs) {
+					return ErrInvalidLengthEvm
+				}
 				if elementCount != 0 && len(m.ExtraEIPs) == 0 {
 					m.ExtraEIPs = make([]int64, 0, elementCount)
 				}
@@ -2064,6 +2157,120 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowUnprotectedTxs = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInitCodeSize", wireType)
+			}
+			m.MaxInitCodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInitCodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasLimit", wireType)
+			}
+			m.BlockGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlockedOpcodes = append(m.BlockedOpcodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvm
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvm
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BlockedOpcodes) == 0 {
+					m.BlockedOpcodes = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlockedOpcodes = append(m.BlockedOpcodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedOpcodes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -3519,6 +3726,25 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRefunded", wireType)
+			}
+			m.GasRefunded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasRefunded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -0,0 +1,147 @@
+package support
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+)
+
+// ----------------------------------------------------------------------------
+// 								 Fee History
+// ----------------------------------------------------------------------------
+
+// FeeHistoryTx holds the gas used and the effective miner tip of a txs
+type FeeHistoryTx struct {
+	GasUsed uint64
+	Reward  *big.Int
+}
+
+// FeeHistoryBlock holds the block data required to compute its fee history
+type FeeHistoryBlock struct {
+	// BaseFee is the base fee of the block, NextBaseFee the projected base fee of the next block
+	BaseFee, NextBaseFee *big.Int
+	GasUsed, GasLimit    uint64
+	// Txs are the ethereum txs included in the block
+	Txs []FeeHistoryTx
+}
+
+// FeeHistoryResult is the fee history over a range of blocks, as returned by eth_feeHistory
+type FeeHistoryResult struct {
+	OldestBlock uint64
+	// BaseFee contains one more entry than the number of blocks, the projected base fee of
+	// the block following the range
+	BaseFee      []*big.Int
+	GasUsedRatio []float64
+	// Reward is only set when reward percentiles are requested
+	Reward [][]*big.Int
+}
+
+// FeeHistoryBlockGetter returns the fee history data of the block at the given height
+type FeeHistoryBlockGetter func(height uint64) (*FeeHistoryBlock, error)
+
+// ComputeFeeHistory computes the fee history of the given number of blocks ending at lastBlock.
+// The range is clamped to the genesis block, and the reward percentiles must be sorted in
+// ascending order within [0, 100].
+func ComputeFeeHistory(
+	blocks uint64,
+	lastBlock uint64,
+	rewardPercentiles []float64,
+	getBlock FeeHistoryBlockGetter,
+) (*FeeHistoryResult, error) {
+	if err := ValidateRewardPercentiles(rewardPercentiles); err != nil {
+		return nil, err
+	}
+
+	if blocks == 0 {
+		return &FeeHistoryResult{}, nil
+	}
+
+	// clamp the range to the available blocks
+	if blocks > lastBlock+1 {
+		blocks = lastBlock + 1
+	}
+	oldestBlock := lastBlock + 1 - blocks
+
+	res := &FeeHistoryResult{
+		OldestBlock:  oldestBlock,
+		BaseFee:      make([]*big.Int, blocks+1),
+		GasUsedRatio: make([]float64, blocks),
+	}
+	if len(rewardPercentiles) != 0 {
+		res.Reward = make([][]*big.Int, blocks)
+	}
+
+	for i := uint64(0); i < blocks; i++ {
+		block, err := getBlock(oldestBlock + i)
+		if err != nil {
+			return nil, err
+		}
+		if block == nil {
+			return nil, fmt.Errorf("block %d not found", oldestBlock+i)
+		}
+		if block.GasLimit == 0 {
+			return nil, fmt.Errorf("gas limit of block %d should be bigger than 0", oldestBlock+i)
+		}
+
+		res.BaseFee[i] = bigOrZero(block.BaseFee)
+		res.BaseFee[i+1] = bigOrZero(block.NextBaseFee)
+		res.GasUsedRatio[i] = float64(block.GasUsed) / float64(block.GasLimit)
+		if res.Reward != nil {
+			res.Reward[i] = FeeHistoryRewards(block.Txs, block.GasUsed, rewardPercentiles)
+		}
+	}
+
+	return res, nil
+}
+
+// ValidateRewardPercentiles checks that the percentiles are within [0, 100] and sorted in
+// ascending order
+func ValidateRewardPercentiles(rewardPercentiles []float64) error {
+	for i, p := range rewardPercentiles {
+		if p < 0 || p > 100 {
+			return fmt.Errorf("invalid reward percentile %f, must be within [0, 100]", p)
+		}
+		if i > 0 && p < rewardPercentiles[i-1] {
+			return fmt.Errorf("invalid reward percentiles, %f at index %d is lower than %f at index %d", p, i, rewardPercentiles[i-1], i-1)
+		}
+	}
+	return nil
+}
+
+// FeeHistoryRewards returns, for each of the percentiles, the reward of the txs for which
+// the cumulative gas used of the txs sorted by reward reaches the percentile of the block
+// gas used. An all zero row is returned if there are no txs.
+func FeeHistoryRewards(txs []FeeHistoryTx, blockGasUsed uint64, rewardPercentiles []float64) []*big.Int {
+	rewards := make([]*big.Int, len(rewardPercentiles))
+	if len(txs) == 0 {
+		for i := range rewards {
+			rewards[i] = big.NewInt(0)
+		}
+		return rewards
+	}
+
+	sorted := make([]FeeHistoryTx, len(txs))
+	copy(sorted, txs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return bigOrZero(sorted[i].Reward).Cmp(bigOrZero(sorted[j].Reward)) < 0
+	})
+
+	var txIndex int
+	sumGasUsed := sorted[0].GasUsed
+	for i, p := range rewardPercentiles {
+		thresholdGasUsed := uint64(float64(blockGasUsed) * p / 100) // #nosec G701
+		for sumGasUsed < thresholdGasUsed && txIndex < len(sorted)-1 {
+			txIndex++
+			sumGasUsed += sorted[txIndex].GasUsed
+		}
+		rewards[i] = bigOrZero(sorted[txIndex].Reward)
+	}
+	return rewards
+}
+
+func bigOrZero(i *big.Int) *big.Int {
+	if i == nil {
+		return big.NewInt(0)
+	}
+	return i
+}
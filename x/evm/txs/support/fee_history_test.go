@@ -0,0 +1,52 @@
+package support
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testFeeHistoryBlock(height uint64) (*FeeHistoryBlock, error) {
+	return &FeeHistoryBlock{
+		BaseFee:     big.NewInt(int64(100 + height)),
+		NextBaseFee: big.NewInt(int64(101 + height)),
+		GasUsed:     50,
+		GasLimit:    100,
+		Txs: []FeeHistoryTx{
+			{GasUsed: 30, Reward: big.NewInt(3)},
+			{GasUsed: 20, Reward: big.NewInt(1)},
+		},
+	}, nil
+}
+
+func TestComputeFeeHistory(t *testing.T) {
+	res, err := ComputeFeeHistory(2, 5, []float64{0, 50, 100}, testFeeHistoryBlock)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), res.OldestBlock)
+	require.Equal(t, []*big.Int{big.NewInt(104), big.NewInt(105), big.NewInt(106)}, res.BaseFee)
+	require.Equal(t, []float64{0.5, 0.5}, res.GasUsedRatio)
+	require.Equal(t, [][]*big.Int{
+		{big.NewInt(1), big.NewInt(3), big.NewInt(3)},
+		{big.NewInt(1), big.NewInt(3), big.NewInt(3)},
+	}, res.Reward)
+}
+
+func TestComputeFeeHistoryBeforeGenesis(t *testing.T) {
+	res, err := ComputeFeeHistory(10, 2, nil, testFeeHistoryBlock)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.OldestBlock)
+	require.Len(t, res.BaseFee, 4)
+	require.Len(t, res.GasUsedRatio, 3)
+	require.Nil(t, res.Reward)
+}
+
+func TestComputeFeeHistoryInvalidPercentiles(t *testing.T) {
+	for _, percentiles := range [][]float64{{-1}, {101}, {50, 10}} {
+		t.Run(fmt.Sprint(percentiles), func(t *testing.T) {
+			_, err := ComputeFeeHistory(1, 1, percentiles, testFeeHistoryBlock)
+			require.Error(t, err)
+		})
+	}
+}
@@ -1,7 +1,11 @@
 package support
 
 import (
+	"errors"
 	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/artela-network/artela/ethereum/types"
 )
@@ -15,9 +19,26 @@ func (ga GenesisAccount) Validate() error {
 	if err := types.ValidateAddress(ga.Address); err != nil {
 		return err
 	}
+	if ga.Balance != "" {
+		if _, err := ga.ExpectedBalance(); err != nil {
+			return err
+		}
+	}
 	return ga.Storage.Validate()
 }
 
+// ExpectedBalance returns the parsed Balance of the GenesisAccount, or nil if it is not set.
+func (ga GenesisAccount) ExpectedBalance() (*big.Int, error) {
+	if ga.Balance == "" {
+		return nil, nil
+	}
+	balance, ok := new(big.Int).SetString(ga.Balance, 10)
+	if !ok || balance.Sign() < 0 {
+		return nil, fmt.Errorf("invalid balance %q, must be a non-negative integer", ga.Balance)
+	}
+	return balance, nil
+}
+
 // ----------------------------------------------------------------------------
 // 							 Genesis State
 // ----------------------------------------------------------------------------
@@ -54,3 +75,33 @@ func (gs GenesisState) Validate() error {
 	}
 	return gs.Params.Validate()
 }
+
+// ValidateBalances checks that the balance of every genesis account with a Balance set equals
+// its balance in the bank module, as returned by balanceOf, and reports all the mismatches.
+// The check is skipped if AllowBalanceMismatch is set.
+func (gs GenesisState) ValidateBalances(balanceOf func(address common.Address) *big.Int) error {
+	if gs.AllowBalanceMismatch {
+		return nil
+	}
+
+	var errs []error
+	for _, acc := range gs.Accounts {
+		expected, err := acc.ExpectedBalance()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("account %s: %w", acc.Address, err))
+			continue
+		}
+		if expected == nil {
+			continue
+		}
+
+		if actual := balanceOf(common.HexToAddress(acc.Address)); actual.Cmp(expected) != 0 {
+			errs = append(errs, fmt.Errorf("account %s: evm balance %s doesn't match bank balance %s %s",
+				acc.Address, expected, actual, gs.Params.EvmDenom))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("genesis balance mismatch: %w", errors.Join(errs...))
+	}
+	return nil
+}
@@ -29,6 +29,9 @@ type GenesisState struct {
 	Accounts []GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// allow_balance_mismatch disables the check of the genesis accounts balances
+	// against the bank module balances, for intentional divergences.
+	AllowBalanceMismatch bool `protobuf:"varint,3,opt,name=allow_balance_mismatch,json=allowBalanceMismatch,proto3" json:"allow_balance_mismatch,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +81,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetAllowBalanceMismatch() bool {
+	if m != nil {
+		return m.AllowBalanceMismatch
+	}
+	return false
+}
+
 // GenesisAccount defines an account to be initialized in the genesis states.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -88,6 +98,9 @@ type GenesisAccount struct {
 	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 	// storage defines the set of states key values for the account.
 	Storage Storage `protobuf:"bytes,3,rep,name=storage,proto3,castrepeated=Storage" json:"storage"`
+	// balance defines the expected balance of the account in evm_denom. When set,
+	// it is checked against the bank module balance at genesis.
+	Balance string `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`
 }
 
 func (m *GenesisAccount) Reset()         { *m = GenesisAccount{} }
@@ -144,6 +157,13 @@ func (m *GenesisAccount) GetStorage() Storage {
 	return nil
 }
 
+func (m *GenesisAccount) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "artela.evm.v1.GenesisState")
 	proto.RegisterType((*GenesisAccount)(nil), "artela.evm.v1.GenesisAccount")
@@ -152,27 +172,29 @@ func init() {
 func init() { proto.RegisterFile("artela/evm/v1/genesis.proto", fileDescriptor_1bf2439c151f2d46) }
 
 var fileDescriptor_1bf2439c151f2d46 = []byte{
-	// 305 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4e, 0x2c, 0x2a, 0x49,
-	0xcd, 0x49, 0xd4, 0x4f, 0x2d, 0xcb, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce,
-	0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x85, 0x48, 0xea, 0xa5, 0x96, 0xe5, 0xea,
-	0x95, 0x19, 0x4a, 0x89, 0xa3, 0xaa, 0x05, 0x89, 0x82, 0xd5, 0x49, 0x89, 0xa4, 0xe7, 0xa7, 0xe7,
-	0x83, 0x99, 0xfa, 0x20, 0x16, 0x44, 0x54, 0xa9, 0x85, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x5e, 0x70,
-	0x49, 0x62, 0x49, 0xaa, 0x90, 0x3d, 0x17, 0x47, 0x62, 0x72, 0x72, 0x7e, 0x69, 0x5e, 0x49, 0xb1,
-	0x04, 0xa3, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0xac, 0x1e, 0x8a, 0x0d, 0x7a, 0x50, 0xe5, 0x8e, 0x10,
-	0x55, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0xc1, 0x35, 0x09, 0x19, 0x73, 0xb1, 0x15, 0x24,
-	0x16, 0x25, 0xe6, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x1b, 0x89, 0xa2, 0x69, 0x0f, 0x00,
-	0x4b, 0x42, 0xb5, 0x41, 0x95, 0x2a, 0xd5, 0x73, 0xf1, 0xa1, 0x1a, 0x2b, 0x24, 0xc1, 0xc5, 0x9e,
-	0x98, 0x92, 0x52, 0x94, 0x5a, 0x0c, 0x72, 0x06, 0xa3, 0x06, 0x67, 0x10, 0x8c, 0x2b, 0x24, 0xc4,
-	0xc5, 0x92, 0x9c, 0x9f, 0x92, 0x0a, 0x36, 0x9e, 0x33, 0x08, 0xcc, 0x16, 0xb2, 0xe7, 0x62, 0x2f,
-	0x2e, 0xc9, 0x2f, 0x4a, 0x4c, 0x4f, 0x95, 0x60, 0x06, 0x3b, 0x5a, 0x04, 0xcd, 0x56, 0xb0, 0xe7,
-	0x9c, 0xf8, 0x41, 0x96, 0xae, 0xba, 0x2f, 0xcf, 0x1e, 0x0c, 0x51, 0x1c, 0x04, 0xd3, 0xe5, 0xe4,
-	0x79, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c,
-	0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xfa, 0xe9, 0x99, 0x25, 0x19,
-	0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x10, 0x33, 0x75, 0xf3, 0x52, 0x4b, 0xca, 0xf3, 0x8b,
-	0xb2, 0xa1, 0x5c, 0x50, 0x30, 0x57, 0x80, 0xc3, 0xbb, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d,
-	0x1c, 0xb2, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x89, 0x20, 0x95, 0xb6, 0x01, 0x00,
-	0x00,
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0x5d, 0x51, 0xbb, 0x4e, 0xc3, 0x30,
+	0x14, 0x6d, 0x68, 0xd4, 0xb4, 0xe6, 0x25, 0x59, 0x05, 0xa2, 0x22, 0xda, 0xaa, 0x53, 0x17, 0x12,
+	0x95, 0xb2, 0x23, 0xb2, 0x20, 0x06, 0x24, 0x94, 0x6e, 0x2c, 0x95, 0x93, 0x5a, 0x69, 0x44, 0x12,
+	0x47, 0xb1, 0x9b, 0xc2, 0x5f, 0xb0, 0xf2, 0x0b, 0x7c, 0x02, 0x5f, 0xd0, 0xb1, 0x23, 0x13, 0x20,
+	0xf8, 0x11, 0xfc, 0x2a, 0x52, 0x3a, 0x5c, 0xe9, 0x5e, 0x9f, 0x73, 0x7c, 0x8e, 0x7d, 0xc1, 0x29,
+	0x2a, 0x18, 0x4e, 0x90, 0x8b, 0xcb, 0xd4, 0x2d, 0x47, 0x6e, 0x84, 0x33, 0x4c, 0x63, 0xea, 0xe4,
+	0x05, 0x61, 0x04, 0xee, 0x2b, 0xd0, 0xe1, 0xa0, 0x53, 0x8e, 0x3a, 0x27, 0x55, 0xae, 0x38, 0x95,
+	0xbc, 0x4e, 0x3b, 0x22, 0x11, 0x91, 0xad, 0x2b, 0x3a, 0x75, 0x3a, 0x78, 0x37, 0xc0, 0xde, 0x8d,
+	0xba, 0x6f, 0xc2, 0x10, 0xc3, 0xf0, 0x0a, 0x34, 0x51, 0x18, 0x92, 0x45, 0xc6, 0xa8, 0x6d, 0xf4,
+	0xeb, 0xc3, 0xdd, 0x8b, 0x33, 0xa7, 0xe2, 0xe0, 0x68, 0xfa, 0xb5, 0x62, 0x79, 0xe6, 0xea, 0xb3,
+	0x57, 0xf3, 0xff, 0x45, 0x70, 0x0c, 0x1a, 0x39, 0x2a, 0x50, 0x4a, 0xed, 0x9d, 0xbe, 0xc1, 0xe5,
+	0x47, 0x5b, 0xf2, 0x7b, 0x09, 0x6a, 0x99, 0xa6, 0xc2, 0x4b, 0x70, 0x8c, 0x92, 0x84, 0x2c, 0xa7,
+	0x01, 0x4a, 0x50, 0x16, 0xe2, 0x69, 0x1a, 0xd3, 0x14, 0xb1, 0x70, 0x6e, 0xd7, 0xf9, 0x25, 0x4d,
+	0xbf, 0x2d, 0x51, 0x4f, 0x81, 0x77, 0x1a, 0x1b, 0xbc, 0x1a, 0xe0, 0xa0, 0x9a, 0x06, 0xda, 0xc0,
+	0x42, 0xb3, 0x59, 0x81, 0xa9, 0x48, 0x6f, 0x0c, 0x5b, 0xfe, 0x66, 0x84, 0x10, 0x98, 0x21, 0x99,
+	0x61, 0x99, 0xaa, 0xe5, 0xcb, 0x9e, 0x3f, 0xd6, 0xa2, 0x8c, 0x14, 0x28, 0xc2, 0xdc, 0x47, 0xbc,
+	0xb5, 0xbd, 0x15, 0x56, 0xfe, 0x89, 0x77, 0x28, 0xb2, 0xbe, 0x7d, 0xf5, 0xac, 0x89, 0x22, 0xfb,
+	0x1b, 0x95, 0xb0, 0xd3, 0x89, 0x6d, 0x53, 0xd9, 0xe9, 0xd1, 0xbb, 0x5d, 0xfd, 0x74, 0x8d, 0x35,
+	0xaf, 0x6f, 0x5e, 0x2f, 0xbf, 0xdd, 0xda, 0x9a, 0xd7, 0x07, 0xaf, 0x07, 0x37, 0x8a, 0xd9, 0x7c,
+	0x11, 0x38, 0x21, 0x49, 0x5d, 0xe5, 0x76, 0x9e, 0x61, 0xb6, 0x24, 0xc5, 0xa3, 0x1e, 0xc5, 0xde,
+	0x9e, 0xe4, 0x02, 0xd9, 0x73, 0x8e, 0x69, 0xd0, 0x90, 0xab, 0x1a, 0xff, 0x01, 0xbd, 0x66, 0xaf,
+	0x10, 0x07, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -195,6 +217,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AllowBalanceMismatch {
+		i--
+		if m.AllowBalanceMismatch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -242,6 +274,13 @@ func (m *GenesisAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Storage) > 0 {
 		for iNdEx := len(m.Storage) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -298,6 +337,9 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.AllowBalanceMismatch {
+		n += 2
+	}
 	return n
 }
 
@@ -321,6 +363,10 @@ func (m *GenesisAccount) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -426,6 +472,26 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowBalanceMismatch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowBalanceMismatch = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -574,6 +640,38 @@ func (m *GenesisAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
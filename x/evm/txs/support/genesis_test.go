@@ -0,0 +1,54 @@
+package support
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidateBalances(t *testing.T) {
+	addrA, addrB, addrC := common.HexToAddress("0xa"), common.HexToAddress("0xb"), common.HexToAddress("0xc")
+	bank := map[common.Address]*big.Int{
+		addrA: big.NewInt(100),
+		addrB: big.NewInt(200),
+		addrC: big.NewInt(300),
+	}
+	balanceOf := func(address common.Address) *big.Int { return bank[address] }
+
+	gs := DefaultGenesisState()
+	gs.Accounts = []GenesisAccount{
+		{Address: addrA.String(), Balance: "100"},
+		{Address: addrB.String(), Balance: "201"},
+		{Address: addrC.String(), Balance: "3"},
+		// accounts without balance are not checked
+		{Address: common.HexToAddress("0xd").String()},
+	}
+	require.NoError(t, gs.Validate())
+
+	// every mismatch is reported
+	err := gs.ValidateBalances(balanceOf)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), addrB.String())
+	require.Contains(t, err.Error(), addrC.String())
+	require.NotContains(t, err.Error(), addrA.String())
+
+	gs.AllowBalanceMismatch = true
+	require.NoError(t, gs.ValidateBalances(balanceOf))
+
+	gs.AllowBalanceMismatch = false
+	gs.Accounts = gs.Accounts[:1]
+	require.NoError(t, gs.ValidateBalances(balanceOf))
+}
+
+func TestGenesisAccountValidateBalance(t *testing.T) {
+	acc := GenesisAccount{Address: common.HexToAddress("0xa").String(), Balance: "-1"}
+	require.Error(t, acc.Validate())
+
+	acc.Balance = "1e18"
+	require.Error(t, acc.Validate())
+
+	acc.Balance = "1000000000000000000"
+	require.NoError(t, acc.Validate())
+}
@@ -0,0 +1,126 @@
+package support
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+)
+
+// ----------------------------------------------------------------------------
+// 								 Log Filter
+// ----------------------------------------------------------------------------
+
+// BloomMatches returns whether the bloom may contain logs matching the given addresses and
+// topics. A false positive is possible, a false negative is not.
+// [] -> anything
+// [A] -> A in first position of log topics, anything after
+// [null, B] -> anything in first position, B in second position
+// [[A, B], [A, B]] -> A or B in first position, A or B in second position
+func BloomMatches(bloom ethereum.Bloom, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 {
+		var included bool
+		for _, addr := range addresses {
+			if ethereum.BloomLookup(bloom, addr) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+
+	for _, sub := range topics {
+		included := len(sub) == 0 // empty rule set == wildcard
+		for _, topic := range sub {
+			if ethereum.BloomLookup(bloom, topic) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+	return true
+}
+
+// LogMatches returns whether the log is emitted by one of the addresses and matches the
+// topics, following the same rules as BloomMatches.
+func LogMatches(log *Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 {
+		var included bool
+		logAddress := common.HexToAddress(log.Address)
+		for _, addr := range addresses {
+			if addr == logAddress {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+
+	// If the to filtered topics is greater than the amount of topics in logs, skip.
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+	for i, sub := range topics {
+		match := len(sub) == 0 // empty rule set == wildcard
+		logTopic := common.HexToHash(log.Topics[i])
+		for _, topic := range sub {
+			if logTopic == topic {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+	return true
+}
+
+// FilterBlockLogs returns the logs of the txs results of a block matching the addresses and
+// topics, ordered by (txIndex, logIndex). The bloom of each txs result is checked first so
+// the logs of the txs that can't match are skipped. The returned logs are copies with the
+// block hash and number set.
+func FilterBlockLogs(
+	blockHash common.Hash,
+	blockNumber uint64,
+	results []TxResult,
+	addresses []common.Address,
+	topics [][]common.Hash,
+) ([]*Log, error) {
+	logs := make([]*Log, 0)
+	for i, res := range results {
+		if len(res.Bloom) > ethereum.BloomByteLength {
+			return nil, fmt.Errorf("invalid bloom length of txs result %d, expected at most %d bytes, got %d", i, ethereum.BloomByteLength, len(res.Bloom))
+		}
+		// txs results stored without a bloom can't be prefiltered
+		if len(res.Bloom) != 0 && !BloomMatches(ethereum.BytesToBloom(res.Bloom), addresses, topics) {
+			continue
+		}
+
+		for _, log := range res.TxLogs.Logs {
+			if log == nil || !LogMatches(log, addresses, topics) {
+				continue
+			}
+			matched := *log
+			matched.BlockHash = blockHash.String()
+			matched.BlockNumber = blockNumber
+			logs = append(logs, &matched)
+		}
+	}
+
+	sort.SliceStable(logs, func(i, j int) bool {
+		if logs[i].TxIndex != logs[j].TxIndex {
+			return logs[i].TxIndex < logs[j].TxIndex
+		}
+		return logs[i].Index < logs[j].Index
+	})
+	return logs, nil
+}
@@ -0,0 +1,78 @@
+package support
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testTxResult(txIndex uint64, logs ...*ethereum.Log) TxResult {
+	for _, log := range logs {
+		log.TxIndex = uint(txIndex)
+	}
+	return TxResult{
+		Bloom:  ethereum.LogsBloom(logs),
+		TxLogs: NewTransactionLogsFromEth(common.BigToHash(common.Big1), logs),
+	}
+}
+
+func TestFilterBlockLogs(t *testing.T) {
+	var (
+		addrA  = common.HexToAddress("0xa")
+		addrB  = common.HexToAddress("0xb")
+		topic1 = common.HexToHash("0x1")
+		topic2 = common.HexToHash("0x2")
+
+		blockHash = common.HexToHash("0xff")
+	)
+
+	// results are stored out of order to check the (txIndex, logIndex) ordering
+	results := []TxResult{
+		testTxResult(1,
+			&ethereum.Log{Address: addrB, Topics: []common.Hash{topic2}, Index: 3},
+			&ethereum.Log{Address: addrA, Topics: []common.Hash{topic2, topic1}, Index: 2},
+		),
+		testTxResult(0,
+			&ethereum.Log{Address: addrA, Topics: []common.Hash{topic1}, Index: 0},
+			&ethereum.Log{Address: addrB, Index: 1},
+		),
+	}
+
+	testCases := []struct {
+		name      string
+		addresses []common.Address
+		topics    [][]common.Hash
+		expected  []uint64
+	}{
+		{"wildcard", nil, nil, []uint64{0, 1, 2, 3}},
+		{"address filter", []common.Address{addrA}, nil, []uint64{0, 2}},
+		{"topic filter", nil, [][]common.Hash{{topic2}}, []uint64{2, 3}},
+		{"address and topic filter", []common.Address{addrB}, [][]common.Hash{{topic2}}, []uint64{3}},
+		{"positional topic filter", nil, [][]common.Hash{{}, {topic1}}, []uint64{2}},
+		{"no match", []common.Address{common.HexToAddress("0xc")}, nil, []uint64{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, err := FilterBlockLogs(blockHash, 10, results, tc.addresses, tc.topics)
+			require.NoError(t, err)
+
+			indexes := make([]uint64, len(logs))
+			for i, log := range logs {
+				indexes[i] = log.Index
+				require.Equal(t, blockHash.String(), log.BlockHash)
+				require.Equal(t, uint64(10), log.BlockNumber)
+			}
+			require.Equal(t, tc.expected, indexes)
+		})
+	}
+
+	// the stored logs are not modified
+	require.Zero(t, results[0].TxLogs.Logs[0].BlockNumber)
+}
+
+func TestFilterBlockLogsInvalidBloom(t *testing.T) {
+	_, err := FilterBlockLogs(common.Hash{}, 1, []TxResult{{Bloom: make([]byte, ethereum.BloomByteLength+1)}}, nil, nil)
+	require.Error(t, err)
+}
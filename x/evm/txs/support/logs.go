@@ -1,11 +1,15 @@
 package support
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	artela "github.com/artela-network/artela/ethereum/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethereum "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -55,6 +59,32 @@ func (tx TransactionLogs) EthLogs() []*ethereum.Log {
 	return LogsToEthereum(tx.Logs)
 }
 
+// TxHash parses the hash of the transaction, with or without the 0x prefix. An error is
+// returned if the hash is empty, e.g. on older data, or malformed.
+func (tx TransactionLogs) TxHash() (common.Hash, error) {
+	hash := strings.TrimSpace(tx.Hash)
+	if hash == "" {
+		return common.Hash{}, errors.New("transaction hash is empty")
+	}
+	if !strings.HasPrefix(hash, "0x") && !strings.HasPrefix(hash, "0X") {
+		hash = "0x" + hash
+	}
+
+	bz, err := hexutil.Decode(hash)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("invalid transaction hash %q: %w", tx.Hash, err)
+	}
+	if len(bz) != common.HashLength {
+		return common.Hash{}, fmt.Errorf("invalid transaction hash %q: length %d, expected %d", tx.Hash, len(bz), common.HashLength)
+	}
+	return common.BytesToHash(bz), nil
+}
+
+// SetTxHash sets the hash of the transaction in its canonical 0x prefixed form.
+func (tx *TransactionLogs) SetTxHash(hash common.Hash) {
+	tx.Hash = hash.Hex()
+}
+
 // ----------------------------------------------------------------------------
 // 							     Log
 // ----------------------------------------------------------------------------
@@ -98,6 +128,100 @@ func (log *Log) ToEthereum() *ethereum.Log {
 	}
 }
 
+// FinalizeLogs stamps the logs of a txs with the block and txs metadata, in order. The log
+// indices are block-global: the logs are indexed from startIndex on, and the index of the
+// log following the last one is returned, to be passed as the startIndex of the next txs of
+// the block.
+func FinalizeLogs(logs []*Log, blockHash common.Hash, blockNumber uint64, txHash common.Hash, txIndex uint64, startIndex uint64) uint64 {
+	index := startIndex
+	for _, log := range logs {
+		if log == nil {
+			continue
+		}
+		log.BlockHash = blockHash.Hex()
+		log.BlockNumber = blockNumber
+		log.TxHash = txHash.Hex()
+		log.TxIndex = txIndex
+		log.Index = index
+		index++
+	}
+	return index
+}
+
+// rpcLog is the eth_getLogs JSON-RPC representation of a Log.
+type rpcLog struct {
+	Address     common.Address `json:"address"`
+	Topics      []common.Hash  `json:"topics"`
+	Data        hexutil.Bytes  `json:"data"`
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	TxHash      common.Hash    `json:"transactionHash"`
+	TxIndex     hexutil.Uint64 `json:"transactionIndex"`
+	BlockHash   common.Hash    `json:"blockHash"`
+	Index       hexutil.Uint64 `json:"logIndex"`
+	Removed     bool           `json:"removed"`
+}
+
+// MarshalRPC returns the JSON encoding of the log in the eth_getLogs object shape, with the
+// quantities as hex and the topics always an array. The proto JSON encoding of the log is
+// left untouched.
+func (log *Log) MarshalRPC() ([]byte, error) {
+	topics := make([]common.Hash, len(log.Topics))
+	for i, topic := range log.Topics {
+		topics[i] = common.HexToHash(topic)
+	}
+
+	return json.Marshal(rpcLog{
+		Address:     common.HexToAddress(log.Address),
+		Topics:      topics,
+		Data:        log.Data,
+		BlockNumber: hexutil.Uint64(log.BlockNumber),
+		TxHash:      common.HexToHash(log.TxHash),
+		TxIndex:     hexutil.Uint64(log.TxIndex),
+		BlockHash:   common.HexToHash(log.BlockHash),
+		Index:       hexutil.Uint64(log.Index),
+		Removed:     log.Removed,
+	})
+}
+
+// DecodeLog decodes the log of an event of the given ABI, matching the event by the first log
+// topic. It returns the event name and the event parameters by name, unpacked from the log
+// data for the non-indexed ones and from the log topics for the indexed ones.
+func DecodeLog(contractABI abi.ABI, log *Log) (string, map[string]interface{}, error) {
+	if len(log.Topics) == 0 {
+		return "", nil, errors.New("log has no topics")
+	}
+
+	event, err := contractABI.EventByID(common.HexToHash(log.Topics[0]))
+	if err != nil {
+		return "", nil, fmt.Errorf("event not found in the ABI: %w", err)
+	}
+
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if len(log.Topics)-1 != len(indexed) {
+		return "", nil, fmt.Errorf("topics count mismatch for event %s, expected %d indexed topics, got %d", event.Name, len(indexed), len(log.Topics)-1)
+	}
+
+	values := make(map[string]interface{})
+	if err := event.Inputs.UnpackIntoMap(values, log.Data); err != nil {
+		return "", nil, fmt.Errorf("failed to unpack data of event %s: %w", event.Name, err)
+	}
+
+	topics := make([]common.Hash, len(indexed))
+	for i, topic := range log.Topics[1:] {
+		topics[i] = common.HexToHash(topic)
+	}
+	if err := abi.ParseTopicsIntoMap(values, indexed, topics); err != nil {
+		return "", nil, fmt.Errorf("failed to parse topics of event %s: %w", event.Name, err)
+	}
+
+	return event.Name, values, nil
+}
+
 func NewLogsFromEth(ethlogs []*ethereum.Log) []*Log {
 	var logs []*Log //nolint: prealloc
 	for _, ethlog := range ethlogs {
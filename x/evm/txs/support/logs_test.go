@@ -0,0 +1,164 @@
+package support
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogMarshalRPC(t *testing.T) {
+	log := &Log{
+		Address: common.HexToAddress("0xc0de").String(),
+		Topics: []string{
+			"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+			common.BigToHash(common.Big1).String(),
+		},
+		Data:        []byte{0x01, 0x02, 0xff},
+		BlockNumber: 436,
+		TxHash:      common.BytesToHash(bytes.Repeat([]byte{0xab}, common.HashLength)).String(),
+		TxIndex:     2,
+		BlockHash:   common.BytesToHash(bytes.Repeat([]byte{0xcd}, common.HashLength)).String(),
+		Index:       10,
+	}
+
+	golden, err := os.ReadFile(filepath.Join("testdata", "rpc_log.json"))
+	require.NoError(t, err)
+
+	bz, err := log.MarshalRPC()
+	require.NoError(t, err)
+	require.Equal(t, string(bytes.TrimSpace(golden)), string(bz))
+
+	// the proto JSON encoding is left untouched
+	protoBz, err := json.Marshal(log)
+	require.NoError(t, err)
+	require.NotEqual(t, bz, protoBz)
+}
+
+func TestLogMarshalRPCEmpty(t *testing.T) {
+	bz, err := (&Log{}).MarshalRPC()
+	require.NoError(t, err)
+
+	var res map[string]interface{}
+	require.NoError(t, json.Unmarshal(bz, &res))
+	require.Equal(t, []interface{}{}, res["topics"])
+	require.Equal(t, "0x", res["data"])
+	require.Equal(t, "0x0", res["blockNumber"])
+	require.Equal(t, false, res["removed"])
+}
+
+const erc20TransferABI = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}]`
+
+func TestDecodeLog(t *testing.T) {
+	erc20ABI, err := abi.JSON(strings.NewReader(erc20TransferABI))
+	require.NoError(t, err)
+
+	from, to := common.HexToAddress("0x1"), common.HexToAddress("0x2")
+	value := big.NewInt(1000)
+	data, err := erc20ABI.Events["Transfer"].Inputs.NonIndexed().Pack(value)
+	require.NoError(t, err)
+
+	log := &Log{
+		Topics: []string{
+			erc20ABI.Events["Transfer"].ID.String(),
+			common.BytesToHash(from.Bytes()).String(),
+			common.BytesToHash(to.Bytes()).String(),
+		},
+		Data: data,
+	}
+
+	name, values, err := DecodeLog(erc20ABI, log)
+	require.NoError(t, err)
+	require.Equal(t, "Transfer", name)
+	require.Equal(t, map[string]interface{}{"from": from, "to": to, "value": value}, values)
+
+	// topic count mismatch
+	_, _, err = DecodeLog(erc20ABI, &Log{Topics: log.Topics[:2], Data: data})
+	require.Error(t, err)
+
+	// unknown event
+	_, _, err = DecodeLog(erc20ABI, &Log{Topics: []string{common.HexToHash("0x1").String()}})
+	require.Error(t, err)
+
+	// no topics
+	_, _, err = DecodeLog(erc20ABI, &Log{})
+	require.Error(t, err)
+}
+
+func TestTransactionLogsTxHash(t *testing.T) {
+	hash := common.HexToHash("0x5b5c2d6e1a0e7d0e4e7b8c9a1f2d3c4b5a69788796a5b4c3d2e1f0a1b2c3d4e5")
+
+	// empty hash of older data
+	_, err := TransactionLogs{}.TxHash()
+	require.Error(t, err)
+
+	// 0x prefixed hash
+	txLogs := TransactionLogs{Hash: hash.Hex()}
+	parsed, err := txLogs.TxHash()
+	require.NoError(t, err)
+	require.Equal(t, hash, parsed)
+
+	// non prefixed hash
+	txLogs = TransactionLogs{Hash: strings.TrimPrefix(hash.Hex(), "0x")}
+	parsed, err = txLogs.TxHash()
+	require.NoError(t, err)
+	require.Equal(t, hash, parsed)
+
+	// malformed hashes
+	for _, malformed := range []string{"0x", "0x1234", "0xzz" + hash.Hex()[4:], hash.Hex() + "00"} {
+		_, err = TransactionLogs{Hash: malformed}.TxHash()
+		require.Error(t, err, malformed)
+	}
+
+	// the hash is written in its canonical form
+	txLogs.SetTxHash(hash)
+	require.Equal(t, hash.Hex(), txLogs.Hash)
+}
+
+func TestFinalizeLogs(t *testing.T) {
+	blockHash := common.HexToHash("0xb10c")
+	tx0Hash, tx1Hash := common.HexToHash("0x1"), common.HexToHash("0x2")
+	newLogs := func(addrs ...string) []*Log {
+		logs := make([]*Log, len(addrs))
+		for i, addr := range addrs {
+			logs[i] = &Log{Address: addr}
+		}
+		return logs
+	}
+
+	tx0Logs := newLogs("0x00000000000000000000000000000000000000a0", "0x00000000000000000000000000000000000000a1")
+	tx1Logs := newLogs("0x00000000000000000000000000000000000000b0", "0x00000000000000000000000000000000000000b1", "0x00000000000000000000000000000000000000b2")
+
+	next := FinalizeLogs(tx0Logs, blockHash, 10, tx0Hash, 0, 0)
+	require.Equal(t, uint64(2), next)
+	next = FinalizeLogs(tx1Logs, blockHash, 10, tx1Hash, 1, next)
+	require.Equal(t, uint64(5), next)
+
+	for i, log := range append(tx0Logs, tx1Logs...) {
+		// the indices are contiguous across the txs and the order is preserved
+		require.Equal(t, uint64(i), log.Index)
+		require.Equal(t, blockHash.Hex(), log.BlockHash)
+		require.Equal(t, uint64(10), log.BlockNumber)
+		require.NoError(t, log.Validate())
+	}
+	require.Equal(t, "0x00000000000000000000000000000000000000a1", tx0Logs[1].Address)
+	require.Equal(t, "0x00000000000000000000000000000000000000b0", tx1Logs[0].Address)
+	for _, log := range tx0Logs {
+		require.Equal(t, tx0Hash.Hex(), log.TxHash)
+		require.Equal(t, uint64(0), log.TxIndex)
+	}
+	for _, log := range tx1Logs {
+		require.Equal(t, tx1Hash.Hex(), log.TxHash)
+		require.Equal(t, uint64(1), log.TxIndex)
+	}
+
+	// a txs without logs leaves the index untouched
+	require.Equal(t, next, FinalizeLogs(nil, blockHash, 10, common.HexToHash("0x3"), 2, next))
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/artela-network/artela/ethereum/utils"
 
 	"github.com/artela-network/artela-evm/vm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 
 	cosmos "github.com/cosmos/cosmos-sdk/types"
@@ -35,6 +38,10 @@ var (
 // https://github.com/ethereum/go-ethereum/blob/master/core/vm/interpreter.go#L97
 var AvailableExtraEIPs = []int64{1344, 1884, 2200, 2929, 3198, 3529}
 
+// MaxExtraEIPs bounds the number of ExtraEIPs of the Params, payloads exceeding it are
+// rejected when decoding the Params instead of allocating their claimed capacity.
+const MaxExtraEIPs = 64
+
 // Parameter keys
 var (
 	ParamStoreKeyEVMDenom            = []byte("EVMDenom")
@@ -67,6 +74,7 @@ func DefaultParams() Params {
 		ChainConfig:         DefaultChainConfig(),
 		ExtraEIPs:           nil,
 		AllowUnprotectedTxs: DefaultAllowUnprotectedTxs,
+		MaxInitCodeSize:     params.MaxInitCodeSize,
 	}
 }
 
@@ -80,6 +88,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateOpcodes(p.BlockedOpcodes); err != nil {
+		return err
+	}
+
 	if err := validateBool(p.EnableCall); err != nil {
 		return err
 	}
@@ -95,6 +107,18 @@ func (p Params) Validate() error {
 	return validateChainConfig(p.ChainConfig)
 }
 
+// Hash returns the keccak256 hash of the proto encoding of the params, e.g. to detect a
+// drift of the params. The fields are encoded in field number order, so the hash is stable
+// for a given proto schema.
+func (p Params) Hash() common.Hash {
+	bz, err := p.Marshal()
+	if err != nil {
+		// the params only hold scalars and sdk integers, which always encode
+		panic(fmt.Errorf("failed to marshal params: %w", err))
+	}
+	return crypto.Keccak256Hash(bz)
+}
+
 // EIPs returns the ExtraEIPS as a int slice
 func (p Params) EIPs() []int {
 	eips := make([]int, len(p.ExtraEIPs))
@@ -104,6 +128,73 @@ func (p Params) EIPs() []int {
 	return eips
 }
 
+// IsOpcodeBlocked returns whether the given opcode is listed in BlockedOpcodes.
+func (p Params) IsOpcodeBlocked(op vm.OpCode) bool {
+	for _, blocked := range p.BlockedOpcodes {
+		if vm.OpCode(blocked) == op {
+			return true
+		}
+	}
+	return false
+}
+
+// IsEIPActivated returns whether the given EIP is active at the given block height.
+// An EIP listed in ExtraEIPs is always active and takes precedence over the forks,
+// otherwise the EIP is active once the ChainConfig hard fork including it is reached.
+func (p Params) IsEIPActivated(eip int, blockHeight *big.Int) bool {
+	for _, extraEIP := range p.ExtraEIPs {
+		if int(extraEIP) == eip {
+			return true
+		}
+	}
+
+	forkBlock, ok := p.ChainConfig.EIPForkBlock(eip)
+	if !ok {
+		return false
+	}
+	return isForked(forkBlock, blockHeight)
+}
+
+// InitCodeSizeLimit returns the maximum init code size enforced once EIP-3860 is activated,
+// falling back to the Ethereum limit when MaxInitCodeSize is not set.
+func (p Params) InitCodeSizeLimit() uint64 {
+	if p.MaxInitCodeSize == 0 {
+		return params.MaxInitCodeSize
+	}
+	return p.MaxInitCodeSize
+}
+
+// EffectiveBlockGasLimit returns the block gas limit used by the EVM given the consensus block
+// gas limit, the BlockGasLimit override if set and lower than the consensus one.
+func (p Params) EffectiveBlockGasLimit(consensusGasLimit uint64) uint64 {
+	if p.BlockGasLimit == 0 || p.BlockGasLimit > consensusGasLimit {
+		return consensusGasLimit
+	}
+	return p.BlockGasLimit
+}
+
+// ValidateBlockGasLimit returns an error if the BlockGasLimit override exceeds the consensus
+// block gas limit. A zero consensus block gas limit, e.g. before the consensus params are set,
+// is not checked against.
+func (p Params) ValidateBlockGasLimit(consensusGasLimit uint64) error {
+	if consensusGasLimit != 0 && p.BlockGasLimit > consensusGasLimit {
+		return fmt.Errorf("block gas limit %d exceeds the consensus block gas limit %d", p.BlockGasLimit, consensusGasLimit)
+	}
+	return nil
+}
+
+// ValidateInitCodeSize returns an error if the init code of a contract creation exceeds the
+// init code size limit while EIP-3860 is activated at the given block height.
+func (p Params) ValidateInitCodeSize(initCode []byte, blockHeight *big.Int) error {
+	if !p.IsEIPActivated(3860, blockHeight) {
+		return nil
+	}
+	if limit := p.InitCodeSizeLimit(); uint64(len(initCode)) > limit {
+		return fmt.Errorf("%w: code size %d, limit %d", core.ErrMaxInitCodeSizeExceeded, len(initCode), limit)
+	}
+	return nil
+}
+
 // Deprecated: ParamKeyTable returns the parameter key table.
 // Usage of x/params to manage parameters is deprecated in favor of x/gov
 // controlled execution of MsgUpdateParams messages. These types remain solely
@@ -149,6 +240,9 @@ func validateEIPs(i interface{}) error {
 	if !ok {
 		return fmt.Errorf("invalid EIP slice type: %T", i)
 	}
+	if len(eips) > MaxExtraEIPs {
+		return fmt.Errorf("too many EIPs: %d, max %d", len(eips), MaxExtraEIPs)
+	}
 
 	for _, eip := range eips {
 		if !vm.ValidEip(int(eip)) {
@@ -159,6 +253,22 @@ func validateEIPs(i interface{}) error {
 	return nil
 }
 
+func validateOpcodes(i interface{}) error {
+	opcodes, ok := i.([]int64)
+	if !ok {
+		return fmt.Errorf("invalid opcode slice type: %T", i)
+	}
+
+	for _, opcode := range opcodes {
+		// undefined opcodes have no name, so their name does not map back to them
+		if opcode < 0 || opcode > 0xff || vm.StringToOp(vm.OpCode(opcode).String()) != vm.OpCode(opcode) {
+			return fmt.Errorf("opcode %d is not a valid opcode", opcode)
+		}
+	}
+
+	return nil
+}
+
 func validateChainConfig(i interface{}) error {
 	cfg, ok := i.(ChainConfig)
 	if !ok {
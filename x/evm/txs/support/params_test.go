@@ -0,0 +1,159 @@
+package support
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/artela-network/artela-evm/vm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	gethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsIsEIPActivated(t *testing.T) {
+	const push0 = 3855
+
+	// PUSH0 activated by the Shanghai fork
+	params := DefaultParams()
+	shanghaiBlock := sdkmath.NewInt(10)
+	params.ChainConfig.ShanghaiBlock = &shanghaiBlock
+	require.False(t, params.IsEIPActivated(push0, big.NewInt(9)))
+	require.True(t, params.IsEIPActivated(push0, big.NewInt(10)))
+
+	// PUSH0 activated through ExtraEIPs without the Shanghai fork
+	params = DefaultParams()
+	params.ChainConfig.ShanghaiBlock = nil
+	require.False(t, params.IsEIPActivated(push0, big.NewInt(100)))
+	params.ExtraEIPs = []int64{push0}
+	require.True(t, params.IsEIPActivated(push0, big.NewInt(0)))
+
+	// unknown EIPs are never active
+	require.False(t, DefaultParams().IsEIPActivated(1, big.NewInt(100)))
+}
+
+func TestParamsInitCodeSizeLimit(t *testing.T) {
+	params := DefaultParams()
+	require.Equal(t, uint64(gethparams.MaxInitCodeSize), params.InitCodeSizeLimit())
+
+	params.MaxInitCodeSize = 0
+	require.Equal(t, uint64(gethparams.MaxInitCodeSize), params.InitCodeSizeLimit())
+
+	params.MaxInitCodeSize = 2 * gethparams.MaxInitCodeSize
+	require.Equal(t, uint64(2*gethparams.MaxInitCodeSize), params.InitCodeSizeLimit())
+}
+
+func TestParamsValidateInitCodeSize(t *testing.T) {
+	params := DefaultParams()
+	shanghaiBlock := sdkmath.NewInt(10)
+	params.ChainConfig.ShanghaiBlock = &shanghaiBlock
+
+	initCode := make([]byte, gethparams.MaxInitCodeSize+1)
+	require.NoError(t, params.ValidateInitCodeSize(initCode[:gethparams.MaxInitCodeSize], big.NewInt(10)))
+	// one byte over the limit is only rejected once Shanghai is activated
+	require.NoError(t, params.ValidateInitCodeSize(initCode, big.NewInt(9)))
+	require.ErrorIs(t, params.ValidateInitCodeSize(initCode, big.NewInt(10)), core.ErrMaxInitCodeSizeExceeded)
+
+	// the limit can be overridden through the params
+	params.MaxInitCodeSize = gethparams.MaxInitCodeSize + 1
+	require.NoError(t, params.ValidateInitCodeSize(initCode, big.NewInt(10)))
+}
+
+func TestParamsBlockGasLimit(t *testing.T) {
+	params := DefaultParams()
+	require.Equal(t, uint64(30_000_000), params.EffectiveBlockGasLimit(30_000_000))
+	require.NoError(t, params.ValidateBlockGasLimit(30_000_000))
+
+	// the override is used when lower than the consensus block gas limit
+	params.BlockGasLimit = 10_000_000
+	require.Equal(t, uint64(10_000_000), params.EffectiveBlockGasLimit(30_000_000))
+	require.NoError(t, params.ValidateBlockGasLimit(30_000_000))
+
+	// and never exceeds it
+	require.Equal(t, uint64(5_000_000), params.EffectiveBlockGasLimit(5_000_000))
+	require.Error(t, params.ValidateBlockGasLimit(5_000_000))
+	require.NoError(t, params.ValidateBlockGasLimit(0))
+}
+
+func TestParamsBlockGasLimitGenesisRoundtrip(t *testing.T) {
+	params := DefaultParams()
+	params.BlockGasLimit = 10_000_000
+	genState := NewGenesisState(params, nil)
+	require.NoError(t, genState.Validate())
+
+	bz, err := genState.Marshal()
+	require.NoError(t, err)
+
+	var decoded GenesisState
+	require.NoError(t, decoded.Unmarshal(bz))
+	require.NoError(t, decoded.Validate())
+	require.Equal(t, uint64(10_000_000), decoded.Params.BlockGasLimit)
+	require.Equal(t, uint64(10_000_000), decoded.Params.EffectiveBlockGasLimit(30_000_000))
+}
+
+func TestParamsBlockedOpcodes(t *testing.T) {
+	params := DefaultParams()
+	require.False(t, params.IsOpcodeBlocked(vm.SELFDESTRUCT))
+
+	params.BlockedOpcodes = []int64{int64(vm.SELFDESTRUCT), int64(vm.STOP)}
+	require.NoError(t, params.Validate())
+	require.True(t, params.IsOpcodeBlocked(vm.SELFDESTRUCT))
+	require.True(t, params.IsOpcodeBlocked(vm.STOP))
+	require.False(t, params.IsOpcodeBlocked(vm.CALL))
+
+	// undefined and out of range opcodes are rejected
+	for _, opcode := range []int64{0x0c, 0xef, 0x100, -1} {
+		params.BlockedOpcodes = []int64{opcode}
+		require.Error(t, params.Validate(), opcode)
+	}
+}
+
+func TestParamsUnmarshalExtraEIPsBound(t *testing.T) {
+	params := DefaultParams()
+	params.ExtraEIPs = make([]int64, MaxExtraEIPs)
+	for i := range params.ExtraEIPs {
+		params.ExtraEIPs[i] = 3855
+	}
+	bz, err := params.Marshal()
+	require.NoError(t, err)
+	var decoded Params
+	require.NoError(t, decoded.Unmarshal(bz))
+	require.Len(t, decoded.ExtraEIPs, MaxExtraEIPs)
+
+	params.ExtraEIPs = append(params.ExtraEIPs, 3855)
+	require.Error(t, params.Validate())
+
+	for _, count := range []int{MaxExtraEIPs + 1, 1 << 16} {
+		// packed field 4 claiming count one byte elements
+		packed := make([]byte, count)
+		for i := range packed {
+			packed[i] = 0x01
+		}
+		payload := append([]byte{0x22}, encodeVarintLength(len(packed))...)
+		payload = append(payload, packed...)
+		require.ErrorIs(t, new(Params).Unmarshal(payload), ErrInvalidLengthEvm, count)
+
+		// unpacked elements
+		var unpacked []byte
+		for i := 0; i < count; i++ {
+			unpacked = append(unpacked, 0x20, 0x01)
+		}
+		require.ErrorIs(t, new(Params).Unmarshal(unpacked), ErrInvalidLengthEvm, count)
+	}
+}
+
+func encodeVarintLength(l int) []byte {
+	buf := make([]byte, sovEvm(uint64(l)))
+	encodeVarintEvm(buf, len(buf), uint64(l))
+	return buf
+}
+
+func TestParamsHash(t *testing.T) {
+	params := DefaultParams()
+	require.Equal(t, params.Hash(), DefaultParams().Hash())
+	require.NotEqual(t, common.Hash{}, params.Hash())
+
+	params.EvmDenom = "aother"
+	require.NotEqual(t, DefaultParams().Hash(), params.Hash())
+}
@@ -1,7 +1,10 @@
 package support
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
+	"sort"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
@@ -14,14 +17,55 @@ import (
 // 							       State
 // ----------------------------------------------------------------------------
 
-// Validate performs a basic validation of the State fields.
-// NOTE: states value can be empty
+// Validate performs a basic validation of the State fields: both the key and the value
+// must be hex strings, optionally 0x prefixed, decoding to exactly 32 bytes.
+// NOTE: states value can be empty, in which case it's treated as a zero slot
 // State represents a single Storage key value pair item.
 func (s State) Validate() error {
 	if strings.TrimSpace(s.Key) == "" {
 		return errorsmod.Wrap(types.ErrInvalidState, "states key hash cannot be blank")
 	}
 
+	if err := validateStateHash(s.Key); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidState, "invalid states key %s: %s", s.Key, err)
+	}
+
+	if s.Value == "" {
+		return nil
+	}
+
+	if err := validateStateHash(s.Value); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidState, "invalid states value %s: %s", s.Value, err)
+	}
+
+	return nil
+}
+
+// ValidateStates validates every State, reporting the index of the first invalid one.
+func ValidateStates(states []State) error {
+	for i, state := range states {
+		if err := state.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "states %d", i)
+		}
+	}
+	return nil
+}
+
+// validateStateHash checks the hex string, with an optional 0x prefix, decodes to a 32 bytes hash.
+func validateStateHash(h string) error {
+	if strings.HasPrefix(h, "0x") || strings.HasPrefix(h, "0X") {
+		h = h[2:]
+	}
+
+	bz, err := hex.DecodeString(h)
+	if err != nil {
+		return err
+	}
+
+	if len(bz) != common.HashLength {
+		return fmt.Errorf("expected %d bytes, got %d", common.HashLength, len(bz))
+	}
+
 	return nil
 }
 
@@ -33,6 +77,18 @@ func NewState(key, value common.Hash) State {
 	}
 }
 
+// SortStates sorts the states in place by the byte value of their hex keys, so that
+// 0x0a is placed before 0x10. The sort is stable, states with an equal key keep their
+// relative order.
+func SortStates(states []State) {
+	sort.SliceStable(states, func(i, j int) bool {
+		return bytes.Compare(
+			common.HexToHash(states[i].Key).Bytes(),
+			common.HexToHash(states[j].Key).Bytes(),
+		) < 0
+	})
+}
+
 // ----------------------------------------------------------------------------
 // 						   State Array - Storage
 // ----------------------------------------------------------------------------
@@ -43,16 +99,16 @@ type Storage []State
 
 // Validate performs a basic validation of the Storage fields.
 func (s Storage) Validate() error {
+	if err := ValidateStates(s); err != nil {
+		return err
+	}
+
 	seenStorage := make(map[string]bool)
 	for i, state := range s {
 		if seenStorage[state.Key] {
 			return errorsmod.Wrapf(types.ErrInvalidState, "duplicate states key %d: %s", i, state.Key)
 		}
 
-		if err := state.Validate(); err != nil {
-			return err
-		}
-
 		seenStorage[state.Key] = true
 	}
 	return nil
@@ -0,0 +1,61 @@
+package support
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortStates(t *testing.T) {
+	states := []State{
+		{Key: "0x10", Value: "0x1"},
+		{Key: "0x0a", Value: "0x2"},
+		{Key: "0xff", Value: "0x3"},
+		{Key: "0x01", Value: "0x4"},
+		{Key: "0x0a", Value: "0x5"},
+	}
+
+	SortStates(states)
+
+	require.Equal(t, []State{
+		{Key: "0x01", Value: "0x4"},
+		{Key: "0x0a", Value: "0x2"},
+		{Key: "0x0a", Value: "0x5"},
+		{Key: "0x10", Value: "0x1"},
+		{Key: "0xff", Value: "0x3"},
+	}, states)
+}
+
+func TestStateValidate(t *testing.T) {
+	key := "0x" + strings.Repeat("01", 32)
+	value := strings.Repeat("ab", 32)
+
+	testCases := []struct {
+		name    string
+		state   State
+		expPass bool
+	}{
+		{"valid", State{Key: key, Value: "0x" + value}, true},
+		{"valid without prefix", State{Key: key[2:], Value: value}, true},
+		{"empty value is a zero slot", State{Key: key}, true},
+		{"blank key", State{Key: " ", Value: value}, false},
+		{"short key", State{Key: "0x" + strings.Repeat("01", 31), Value: value}, false},
+		{"non hex key", State{Key: "0x" + strings.Repeat("zz", 32), Value: value}, false},
+		{"short value", State{Key: key, Value: "0x01"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.state.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	err := ValidateStates([]State{{Key: key, Value: value}, {Key: key, Value: "0x01"}})
+	require.ErrorContains(t, err, "states 1")
+}
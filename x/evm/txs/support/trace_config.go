@@ -0,0 +1,80 @@
+package support
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultTraceTimeout is the default timeout of a single transaction trace.
+	DefaultTraceTimeout = 5 * time.Second
+
+	// DefaultTraceReexec is the default number of blocks the tracer is willing to go back
+	// and re-execute to produce missing historical states, as in go-ethereum.
+	DefaultTraceReexec = uint64(128)
+)
+
+// ----------------------------------------------------------------------------
+// 							   Trace Config
+// ----------------------------------------------------------------------------
+
+// WithDefaults returns a copy of the TraceConfig, or of an empty one if nil, with the unset
+// Timeout and Reexec filled with DefaultTraceTimeout and DefaultTraceReexec. The capture
+// flags are left as provided and the receiver is not modified.
+func (tc *TraceConfig) WithDefaults() *TraceConfig {
+	cfg := &TraceConfig{}
+	if tc != nil {
+		*cfg = *tc
+	}
+
+	if cfg.Timeout == "" {
+		cfg.Timeout = DefaultTraceTimeout.String()
+	}
+	if cfg.Reexec == 0 {
+		cfg.Reexec = DefaultTraceReexec
+	}
+	return cfg
+}
+
+// Validate performs a basic validation of the TraceConfig fields, so that a malformed
+// config is rejected before it reaches the tracer.
+func (tc TraceConfig) Validate() error {
+	if _, err := tc.TimeoutDuration(); err != nil {
+		return err
+	}
+
+	if tc.Limit < 0 {
+		return fmt.Errorf("output limit cannot be negative, got %d", tc.Limit)
+	}
+
+	if tc.TracerJsonConfig != "" {
+		if tc.Tracer == "" {
+			return errors.New("tracer config is set but no tracer is specified")
+		}
+		if !json.Valid([]byte(tc.TracerJsonConfig)) {
+			return fmt.Errorf("invalid tracer config for %s: malformed json", tc.Tracer)
+		}
+	}
+
+	return nil
+}
+
+// TimeoutDuration returns the parsed Timeout of the TraceConfig, or DefaultTraceTimeout
+// if no timeout is set.
+func (tc TraceConfig) TimeoutDuration() (time.Duration, error) {
+	if tc.Timeout == "" {
+		return DefaultTraceTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(tc.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("timeout value: %w", err)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("timeout value must be positive, got %s", tc.Timeout)
+	}
+
+	return timeout, nil
+}
@@ -0,0 +1,66 @@
+package support
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceConfigTimeoutDuration(t *testing.T) {
+	testCases := []struct {
+		name    string
+		timeout string
+		expPass bool
+		exp     time.Duration
+	}{
+		{"empty", "", true, DefaultTraceTimeout},
+		{"valid", "10s", true, 10 * time.Second},
+		{"malformed", "5sx", false, 0},
+		{"negative", "-1s", false, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := TraceConfig{Timeout: tc.timeout}
+			timeout, err := cfg.TimeoutDuration()
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.exp, timeout)
+				require.NoError(t, cfg.Validate())
+			} else {
+				require.Error(t, err)
+				require.Error(t, cfg.Validate())
+			}
+		})
+	}
+}
+
+func TestTraceConfigValidate(t *testing.T) {
+	require.Error(t, TraceConfig{Limit: -1}.Validate())
+	require.Error(t, TraceConfig{TracerJsonConfig: `{"onlyTopCall":true}`}.Validate())
+	require.Error(t, TraceConfig{Tracer: "callTracer", TracerJsonConfig: `{"onlyTopCall":`}.Validate())
+	require.NoError(t, TraceConfig{Tracer: "callTracer", TracerJsonConfig: `{"onlyTopCall":true}`}.Validate())
+}
+
+func TestTraceConfigWithDefaults(t *testing.T) {
+	empty := &TraceConfig{}
+	cfg := empty.WithDefaults()
+	require.Equal(t, &TraceConfig{Timeout: "5s", Reexec: DefaultTraceReexec}, cfg)
+	require.Equal(t, &TraceConfig{}, empty)
+
+	var nilCfg *TraceConfig
+	require.Equal(t, cfg, nilCfg.WithDefaults())
+
+	populated := &TraceConfig{
+		Tracer:         "callTracer",
+		Timeout:        "10s",
+		Reexec:         16,
+		DisableStack:   true,
+		DisableStorage: true,
+		EnableMemory:   true,
+		Limit:          100,
+	}
+	require.Equal(t, populated, populated.WithDefaults())
+	require.NotSame(t, populated, populated.WithDefaults())
+}
@@ -0,0 +1,157 @@
+package support
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/big"
+
+	artela "github.com/artela-network/artela/ethereum/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+// ----------------------------------------------------------------------------
+// 								 Tx Result
+// ----------------------------------------------------------------------------
+
+// DefaultRevertReason is returned by RevertReason when the revert data carries no
+// decodable reason.
+const DefaultRevertReason = "execution reverted"
+
+var (
+	// errorSelector is the 4-byte selector of the solidity Error(string) revert
+	errorSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+	// panicSelector is the 4-byte selector of the solidity Panic(uint256) revert
+	panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+
+	// panicReasons maps the solidity panic codes to their description
+	panicReasons = map[uint64]string{
+		0x00: "generic panic",
+		0x01: "assert(false)",
+		0x11: "arithmetic overflow",
+		0x12: "division or modulo by zero",
+		0x21: "enum overflow",
+		0x22: "invalid encoded storage byte array accessed",
+		0x31: "out-of-bounds array access; popping on an empty array",
+		0x32: "out-of-bounds access of an array or bytesN",
+		0x41: "out of memory",
+		0x51: "uninitialized function",
+	}
+)
+
+// ToReceipt returns an Ethereum compatible receipt from the TxResult, for the txs of the
+// given hash included at the given block number. An error is returned if the stored
+// contract address or bloom are malformed.
+func (res TxResult) ToReceipt(txHash common.Hash, blockNumber uint64, cumulativeGasUsed uint64) (*ethereum.Receipt, error) {
+	var contractAddress common.Address
+	if res.ContractAddress != "" {
+		if err := artela.ValidateAddress(res.ContractAddress); err != nil {
+			return nil, fmt.Errorf("invalid contract address: %w", err)
+		}
+		contractAddress = common.HexToAddress(res.ContractAddress)
+	}
+
+	if len(res.Bloom) > ethereum.BloomByteLength {
+		return nil, fmt.Errorf("invalid bloom length, expected at most %d bytes, got %d", ethereum.BloomByteLength, len(res.Bloom))
+	}
+
+	status := ethereum.ReceiptStatusSuccessful
+	if res.Reverted {
+		status = ethereum.ReceiptStatusFailed
+	}
+
+	return &ethereum.Receipt{
+		Status:            status,
+		CumulativeGasUsed: cumulativeGasUsed,
+		Bloom:             ethereum.BytesToBloom(res.Bloom),
+		Logs:              res.TxLogs.EthLogs(),
+		TxHash:            txHash,
+		ContractAddress:   contractAddress,
+		GasUsed:           res.GasUsed,
+		BlockNumber:       new(big.Int).SetUint64(blockNumber),
+	}, nil
+}
+
+// ComputeContractAddress returns the address of the contract deployed with CREATE by the
+// sender at the given nonce, i.e. keccak256(rlp([sender, nonce]))[12:]. It matches the
+// ContractAddress of the TxResult of a contract creation txs.
+func ComputeContractAddress(sender common.Address, nonce uint64) common.Address {
+	return crypto.CreateAddress(sender, nonce)
+}
+
+// ComputeContractAddress2 returns the address of the contract deployed with CREATE2 by the
+// sender, i.e. keccak256(0xff ++ sender ++ salt ++ keccak256(initCode))[12:], see EIP-1014.
+func ComputeContractAddress2(sender common.Address, salt [32]byte, initCodeHash common.Hash) common.Address {
+	return crypto.CreateAddress2(sender, salt, initCodeHash.Bytes())
+}
+
+// Err returns the types.ExecutionError of a reverted execution, carrying its revert data,
+// or nil if the execution was not reverted.
+func (res TxResult) Err() error {
+	if !res.Reverted {
+		return nil
+	}
+	return types.NewExecutionError(types.ErrExecutionReverted.Error(), res.Ret)
+}
+
+// RevertReason decodes the revert data of a reverted execution, supporting both the
+// solidity Error(string) and Panic(uint256) payloads. An empty string is returned for
+// successful executions, and DefaultRevertReason for empty or unknown revert data.
+func (res TxResult) RevertReason() (string, error) {
+	if !res.Reverted {
+		return "", nil
+	}
+
+	if len(res.Ret) < 4 {
+		return DefaultRevertReason, nil
+	}
+
+	selector, data := res.Ret[:4], res.Ret[4:]
+	switch {
+	case bytes.Equal(selector, errorSelector):
+		reason, err := abi.UnpackRevert(res.Ret)
+		if err != nil {
+			return "", fmt.Errorf("invalid Error(string) revert data: %w", err)
+		}
+		return reason, nil
+	case bytes.Equal(selector, panicSelector):
+		if len(data) != common.HashLength {
+			return "", fmt.Errorf("invalid Panic(uint256) revert data length %d", len(data))
+		}
+		code := new(big.Int).SetBytes(data)
+		reason := "unknown panic code"
+		if code.IsUint64() {
+			if r, ok := panicReasons[code.Uint64()]; ok {
+				reason = r
+			}
+		}
+		return fmt.Sprintf("panic: %#x (%s)", code, reason), nil
+	default:
+		return DefaultRevertReason, nil
+	}
+}
+
+// CumulativeGasUsed returns the running sum of the gas used by the txs results, aligned with
+// the results order. The gas used by reverted txs is still accounted as reverts burn gas, nil
+// results are accounted as no gas used. The sums saturate at math.MaxUint64 instead of
+// overflowing.
+func CumulativeGasUsed(results []*TxResult) []uint64 {
+	cumulative := make([]uint64, len(results))
+	var sum uint64
+	for i, res := range results {
+		if res != nil {
+			if sum > math.MaxUint64-res.GasUsed {
+				sum = math.MaxUint64
+			} else {
+				sum += res.GasUsed
+			}
+		}
+		cumulative[i] = sum
+	}
+	return cumulative
+}
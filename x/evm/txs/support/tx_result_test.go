@@ -0,0 +1,119 @@
+package support
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxResultToReceipt(t *testing.T) {
+	txHash := common.HexToHash("0x1")
+	contract := common.HexToAddress("0x2")
+
+	receipt, err := TxResult{GasUsed: 21000}.ToReceipt(txHash, 10, 42000)
+	require.NoError(t, err)
+	require.Equal(t, ethereum.ReceiptStatusSuccessful, receipt.Status)
+	require.Equal(t, common.Address{}, receipt.ContractAddress)
+	require.Equal(t, uint64(21000), receipt.GasUsed)
+	require.Equal(t, uint64(42000), receipt.CumulativeGasUsed)
+	require.Equal(t, uint64(10), receipt.BlockNumber.Uint64())
+	require.Equal(t, txHash, receipt.TxHash)
+
+	receipt, err = TxResult{Reverted: true, ContractAddress: contract.Hex()}.ToReceipt(txHash, 10, 0)
+	require.NoError(t, err)
+	require.Equal(t, ethereum.ReceiptStatusFailed, receipt.Status)
+	require.Equal(t, contract, receipt.ContractAddress)
+
+	_, err = TxResult{ContractAddress: "0xinvalid"}.ToReceipt(txHash, 10, 0)
+	require.Error(t, err)
+}
+
+func TestTxResultRevertReason(t *testing.T) {
+	stringTy, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	errorData, err := abi.Arguments{{Type: stringTy}}.Pack("insufficient balance")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		res      TxResult
+		expected string
+		expErr   bool
+	}{
+		{"not reverted", TxResult{Ret: []byte{0x1}}, "", false},
+		{"empty revert", TxResult{Reverted: true}, DefaultRevertReason, false},
+		{"unknown selector", TxResult{Reverted: true, Ret: []byte{0xde, 0xad, 0xbe, 0xef}}, DefaultRevertReason, false},
+		{"error string", TxResult{Reverted: true, Ret: append(common.CopyBytes(errorSelector), errorData...)}, "insufficient balance", false},
+		{"panic", TxResult{Reverted: true, Ret: append(common.CopyBytes(panicSelector), common.BigToHash(big.NewInt(0x11)).Bytes()...)}, "panic: 0x11 (arithmetic overflow)", false},
+		{"malformed panic", TxResult{Reverted: true, Ret: append(common.CopyBytes(panicSelector), 0x11)}, "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reason, err := tc.res.RevertReason()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, reason)
+		})
+	}
+}
+
+func TestCumulativeGasUsed(t *testing.T) {
+	results := []*TxResult{
+		{GasUsed: 21000},
+		{GasUsed: 50000, Reverted: true},
+		nil,
+		{GasUsed: 30000},
+	}
+	require.Equal(t, []uint64{21000, 71000, 71000, 101000}, CumulativeGasUsed(results))
+	require.Empty(t, CumulativeGasUsed(nil))
+
+	// the sums saturate instead of overflowing
+	overflow := []*TxResult{{GasUsed: math.MaxUint64 - 1}, {GasUsed: 2}, {GasUsed: 1}}
+	require.Equal(t, []uint64{math.MaxUint64 - 1, math.MaxUint64, math.MaxUint64}, CumulativeGasUsed(overflow))
+}
+
+func TestComputeContractAddress(t *testing.T) {
+	// the contracts deployed by 0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0 on mainnet
+	sender := common.HexToAddress("0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0")
+	expected := []string{
+		"0xcd234a471b72ba2f1ccf0a70fcaba648a5eecd8d",
+		"0x343c43a37d37dff08ae8c4a11544c718abb4fcf8",
+		"0xf778b86fa74e846c4f0a1fbd1335fe81c00a0c91",
+		"0xfffd933a0bc612844eaf0c6fe3e5b8e9b6c1d19c",
+	}
+	for nonce, addr := range expected {
+		require.Equal(t, common.HexToAddress(addr), ComputeContractAddress(sender, uint64(nonce)))
+	}
+}
+
+func TestComputeContractAddress2(t *testing.T) {
+	// the examples of EIP-1014
+	testCases := []struct {
+		sender   string
+		salt     string
+		initCode string
+		expected string
+	}{
+		{"0x0000000000000000000000000000000000000000", "0x00", "0x00", "0x4D1A2e2bB4F88F0250f26Ffff098B0b30B26BF38"},
+		{"0xdeadbeef00000000000000000000000000000000", "0x00", "0x00", "0xB928f69Bb1D91Cd65274e3c79d8986362984fDA3"},
+		{"0xdeadbeef00000000000000000000000000000000", "0x000000000000000000000000feed000000000000000000000000000000000000", "0x00", "0xD04116cDd17beBE565EB2422F2497E06cC1C9833"},
+		{"0x0000000000000000000000000000000000000000", "0x00", "0xdeadbeef", "0x70f2b2914A2a4b783FaEFb75f459A580616Fcb5e"},
+		{"0x00000000000000000000000000000000deadbeef", "0x00000000000000000000000000000000000000000000000000000000cafebabe", "0xdeadbeef", "0x60f3f640a8508fC6a86d45DF051962668E1e8AC7"},
+		{"0x0000000000000000000000000000000000000000", "0x00", "0x", "0xE33C0C7F7df4809055C3ebA6c09CFe4BaF1BD9e0"},
+	}
+	for _, tc := range testCases {
+		salt := common.HexToHash(tc.salt)
+		initCodeHash := crypto.Keccak256Hash(common.FromHex(tc.initCode))
+		require.Equal(t, common.HexToAddress(tc.expected), ComputeContractAddress2(common.HexToAddress(tc.sender), salt, initCodeHash))
+	}
+}
@@ -0,0 +1,93 @@
+package txs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/artela-network/artela-evm/tracers"
+	"github.com/artela-network/artela-evm/vm"
+)
+
+// ErrTraceTimeout is returned when a trace is aborted because its timeout elapsed or its
+// context was cancelled.
+var ErrTraceTimeout = errors.New("tracing timed out")
+
+// TraceInterrupter aborts a traced execution once the trace timeout elapses or the parent
+// context, e.g. the one of the gRPC request, is cancelled. On interruption the tracer is
+// stopped, which halts a JavaScript tracer, and the EVM registered through SetEVM is
+// cancelled, so the interpreter loop exits at the next opcode.
+type TraceInterrupter struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	tracer tracers.Tracer
+
+	mu          sync.Mutex
+	evm         *vm.EVM
+	interrupted bool
+	released    bool
+}
+
+// NewTraceInterrupter creates a TraceInterrupter watching the given tracer. Release must be
+// called once the traced execution is over.
+func NewTraceInterrupter(parent context.Context, timeout time.Duration, tracer tracers.Tracer) *TraceInterrupter {
+	ctx, cancel := context.WithTimeout(parent, timeout)
+	ti := &TraceInterrupter{
+		ctx:    ctx,
+		cancel: cancel,
+		tracer: tracer,
+	}
+	go ti.watch()
+	return ti
+}
+
+func (ti *TraceInterrupter) watch() {
+	<-ti.ctx.Done()
+
+	ti.mu.Lock()
+	defer ti.mu.Unlock()
+	if ti.released {
+		return
+	}
+
+	ti.interrupted = true
+	ti.tracer.Stop(ErrTraceTimeout)
+	if ti.evm != nil {
+		ti.evm.Cancel()
+	}
+}
+
+// SetEVM registers the EVM executing the traced message, it is cancelled right away if the
+// trace has already been interrupted.
+func (ti *TraceInterrupter) SetEVM(evm *vm.EVM) {
+	ti.mu.Lock()
+	defer ti.mu.Unlock()
+
+	ti.evm = evm
+	if ti.interrupted && evm != nil {
+		evm.Cancel()
+	}
+}
+
+// Err returns ErrTraceTimeout if the trace has been interrupted, in which case the output of
+// the tracer is partial and must be discarded.
+func (ti *TraceInterrupter) Err() error {
+	ti.mu.Lock()
+	defer ti.mu.Unlock()
+
+	if !ti.interrupted {
+		return nil
+	}
+	return ErrTraceTimeout
+}
+
+// Release stops watching the trace, any later timeout or cancellation is ignored.
+func (ti *TraceInterrupter) Release() {
+	ti.mu.Lock()
+	ti.released = true
+	ti.evm = nil
+	ti.mu.Unlock()
+
+	ti.cancel()
+}
@@ -0,0 +1,65 @@
+package txs
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// slowTracer simulates a runaway tracer, it only returns once stopped.
+type slowTracer struct {
+	NoOpTracer
+	stopped chan error
+}
+
+func newSlowTracer() *slowTracer {
+	return &slowTracer{stopped: make(chan error, 1)}
+}
+
+func (t *slowTracer) GetResult() (json.RawMessage, error) { return json.RawMessage(`{}`), nil }
+
+func (t *slowTracer) Stop(err error) { t.stopped <- err }
+
+func (t *slowTracer) run() error {
+	select {
+	case err := <-t.stopped:
+		return err
+	case <-time.After(5 * time.Second):
+		return nil
+	}
+}
+
+func TestTraceInterrupterTimeout(t *testing.T) {
+	tracer := newSlowTracer()
+	ti := NewTraceInterrupter(context.Background(), 10*time.Millisecond, tracer)
+	defer ti.Release()
+
+	require.ErrorIs(t, tracer.run(), ErrTraceTimeout)
+	require.ErrorIs(t, ti.Err(), ErrTraceTimeout)
+	require.EqualError(t, ti.Err(), "tracing timed out")
+}
+
+func TestTraceInterrupterCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	tracer := newSlowTracer()
+	ti := NewTraceInterrupter(ctx, time.Minute, tracer)
+	defer ti.Release()
+
+	cancel()
+	require.ErrorIs(t, tracer.run(), ErrTraceTimeout)
+	require.ErrorIs(t, ti.Err(), ErrTraceTimeout)
+}
+
+func TestTraceInterrupterRelease(t *testing.T) {
+	tracer := newSlowTracer()
+	ti := NewTraceInterrupter(context.Background(), 10*time.Millisecond, tracer)
+	ti.Release()
+
+	// the timeout of a completed trace is ignored
+	time.Sleep(50 * time.Millisecond)
+	require.NoError(t, ti.Err())
+	require.Empty(t, tracer.stopped)
+}
@@ -1,15 +1,25 @@
 package txs
 
 import (
+	"encoding/json"
+	"fmt"
 	"math/big"
 	"os"
+	"strings"
 
+	"github.com/artela-network/artela-evm/tracers"
+	// register the javascript and native tracers in the default directory
+	_ "github.com/artela-network/artela-evm/tracers/js"
 	"github.com/artela-network/artela-evm/tracers/logger"
+	_ "github.com/artela-network/artela-evm/tracers/native"
 
 	"github.com/artela-network/artela-evm/vm"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/artela-network/artela/x/evm/txs/support"
 )
 
 const (
@@ -22,6 +32,20 @@ const (
 	TracerMarkdown = "markdown"
 )
 
+// Built-in tracers which can be selected by name through TraceConfig.Tracer
+const (
+	TracerCall = "callTracer"
+
+	TracerPrestate = "prestateTracer"
+
+	Tracer4Byte = "4byteTracer"
+
+	TracerNoop = "noopTracer"
+)
+
+// BuiltinTracers lists the names of the built-in tracers accepted by ResolveTracer.
+var BuiltinTracers = []string{TracerCall, TracerPrestate, Tracer4Byte, TracerNoop}
+
 var _ vm.EVMLogger = &NoOpTracer{}
 
 // TxTraceResult is the result of a single txs trace during a block trace.
@@ -53,6 +77,162 @@ func NewTracer(tracer string, msg *core.Message, cfg *params.ChainConfig, height
 	}
 }
 
+// ResolveTracer constructs the tracer selected by the given TraceConfig:
+//   - an empty Tracer selects the struct logger, configured by the capture flags.
+//   - a built-in tracer name selects the corresponding native tracer.
+//   - a Tracer that looks like JavaScript source is evaluated as a JS tracer.
+//
+// The TracerJsonConfig is passed to both the built-in and the JS tracers. An error
+// is returned if the Tracer is neither a known built-in nor a JS tracer.
+func ResolveTracer(cfg *support.TraceConfig, tCtx *tracers.Context, chainID *big.Int) (tracers.Tracer, error) {
+	if cfg == nil {
+		cfg = &support.TraceConfig{}
+	}
+
+	if cfg.Tracer == "" {
+		var overrides *params.ChainConfig
+		if cfg.Overrides != nil {
+			overrides = cfg.Overrides.EthereumConfig(chainID)
+		}
+
+		return logger.NewStructLogger(&logger.Config{
+			EnableMemory:     cfg.EnableMemory,
+			DisableStorage:   cfg.DisableStorage,
+			DisableStack:     cfg.DisableStack,
+			EnableReturnData: cfg.EnableReturnData,
+			Debug:            cfg.Debug,
+			Limit:            int(cfg.Limit),
+			Overrides:        overrides,
+		}), nil
+	}
+
+	if !isBuiltinTracer(cfg.Tracer) && !isJSTracer(cfg.Tracer) {
+		return nil, fmt.Errorf("unknown tracer %q, valid built-in tracers are: %s",
+			cfg.Tracer, strings.Join(BuiltinTracers, ", "))
+	}
+
+	var tracerConfig json.RawMessage
+	if cfg.TracerJsonConfig != "" {
+		tracerConfig = json.RawMessage(cfg.TracerJsonConfig)
+	}
+
+	return tracers.DefaultDirectory.New(cfg.Tracer, tCtx, tracerConfig)
+}
+
+// isBuiltinTracer returns true if the name matches one of the built-in tracers.
+func isBuiltinTracer(name string) bool {
+	for _, tracer := range BuiltinTracers {
+		if tracer == name {
+			return true
+		}
+	}
+	return false
+}
+
+// isJSTracer returns true if the tracer is a JavaScript source rather than a tracer name,
+// i.e. it declares an object literal or a function.
+func isJSTracer(tracer string) bool {
+	return strings.ContainsAny(tracer, "{}()")
+}
+
+// ===============================================================
+//          		    Struct Logger Result
+// ===============================================================
+
+// StructLogRes stores a structured log emitted by the EVM while replaying a
+// txs in debug mode, following the go-ethereum debug_traceTransaction layout.
+type StructLogRes struct {
+	Pc            uint64             `json:"pc"`
+	Op            string             `json:"op"`
+	Gas           uint64             `json:"gas"`
+	GasCost       uint64             `json:"gasCost"`
+	Depth         int                `json:"depth"`
+	Error         string             `json:"error,omitempty"`
+	Stack         *[]string          `json:"stack,omitempty"`
+	Memory        *[]string          `json:"memory,omitempty"`
+	ReturnData    string             `json:"returnData,omitempty"`
+	Storage       *map[string]string `json:"storage,omitempty"`
+	RefundCounter uint64             `json:"refund,omitempty"`
+}
+
+// ExecutionResult groups all structured logs emitted by the EVM while replaying
+// a txs in debug mode as well as txs execution status, the amount of gas used
+// and the return value.
+type ExecutionResult struct {
+	Gas         uint64         `json:"gas"`
+	Failed      bool           `json:"failed"`
+	ReturnValue string         `json:"returnValue"`
+	StructLogs  []StructLogRes `json:"structLogs"`
+}
+
+// NewExecutionResult builds the struct logger trace result of an executed message.
+func NewExecutionResult(res *MsgEthereumTxResponse, logs []logger.StructLog, cfg *support.TraceConfig) *ExecutionResult {
+	returnVal := res.Ret
+	// the output of a failed txs is only kept if it was reverted, as it carries the revert reason
+	if res.Failed() && res.Revert() == nil {
+		returnVal = []byte{}
+	}
+
+	return &ExecutionResult{
+		Gas:         res.GasUsed,
+		Failed:      res.Failed(),
+		ReturnValue: fmt.Sprintf("%x", returnVal),
+		StructLogs:  FormatStructLogs(logs, cfg),
+	}
+}
+
+// FormatStructLogs formats the EVM structured logs for json output. The per-step gas cost
+// and refund counter are always reported, while stack, memory, return data and storage are
+// only populated when the corresponding capture flags of the TraceConfig are enabled.
+func FormatStructLogs(logs []logger.StructLog, cfg *support.TraceConfig) []StructLogRes {
+	if cfg == nil {
+		cfg = &support.TraceConfig{}
+	}
+
+	formatted := make([]StructLogRes, len(logs))
+	for index, trace := range logs {
+		formatted[index] = StructLogRes{
+			Pc:            trace.Pc,
+			Op:            trace.Op.String(),
+			Gas:           trace.Gas,
+			GasCost:       trace.GasCost,
+			Depth:         trace.Depth,
+			Error:         trace.ErrorString(),
+			RefundCounter: trace.RefundCounter,
+		}
+
+		if !cfg.DisableStack && trace.Stack != nil {
+			stack := make([]string, len(trace.Stack))
+			for i, stackValue := range trace.Stack {
+				stack[i] = stackValue.Hex()
+			}
+			formatted[index].Stack = &stack
+		}
+
+		if cfg.EnableMemory && trace.Memory != nil {
+			memory := make([]string, 0, (len(trace.Memory)+31)/32)
+			for i := 0; i+32 <= len(trace.Memory); i += 32 {
+				memory = append(memory, fmt.Sprintf("%x", trace.Memory[i:i+32]))
+			}
+			formatted[index].Memory = &memory
+		}
+
+		if cfg.EnableReturnData && len(trace.ReturnData) > 0 {
+			formatted[index].ReturnData = hexutil.Encode(trace.ReturnData)
+		}
+
+		if !cfg.DisableStorage && trace.Storage != nil {
+			storage := make(map[string]string, len(trace.Storage))
+			for i, storageValue := range trace.Storage {
+				storage[fmt.Sprintf("%x", i)] = fmt.Sprintf("%x", storageValue)
+			}
+			formatted[index].Storage = &storage
+		}
+	}
+
+	return formatted
+}
+
 // ===============================================================
 //          		        NoOp Tracer
 // ===============================================================
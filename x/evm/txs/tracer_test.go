@@ -0,0 +1,32 @@
+package txs
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/txs/support"
+)
+
+func TestResolveTracerPrestateDiffMode(t *testing.T) {
+	tracer, err := ResolveTracer(&support.TraceConfig{
+		Tracer:           TracerPrestate,
+		TracerJsonConfig: `{"diffMode":true}`,
+	}, nil, nil)
+	require.NoError(t, err)
+
+	// the diff mode result is split into the pre and post states
+	bz, err := tracer.GetResult()
+	require.NoError(t, err)
+	var res map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &res))
+	require.Contains(t, res, "pre")
+	require.Contains(t, res, "post")
+
+	_, err = ResolveTracer(&support.TraceConfig{
+		Tracer:           TracerPrestate,
+		TracerJsonConfig: `{"diffMode":"yes"}`,
+	}, nil, nil)
+	require.Error(t, err)
+}
@@ -314,6 +314,9 @@ type MsgEthereumTxResponse struct {
 	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
 	// cumulative gas used
 	CumulativeGasUsed uint64 `protobuf:"varint,6,opt,name=cumulative_gas_used,json=cumulativeGasUsed,proto3" json:"cumulative_gas_used,omitempty"`
+	// gas_refunded specifies how much gas was refunded to the sender, capped per
+	// EIP-3529. gas_used is net of this refund.
+	GasRefunded uint64 `protobuf:"varint,7,opt,name=gas_refunded,json=gasRefunded,proto3" json:"gas_refunded,omitempty"`
 }
 
 func (m *MsgEthereumTxResponse) Reset()         { *m = MsgEthereumTxResponse{} }
@@ -457,70 +460,70 @@ func init() {
 func init() { proto.RegisterFile("artela/evm/v1/txs.proto", fileDescriptor_3c43c0836c37bbe6) }
 
 var fileDescriptor_3c43c0836c37bbe6 = []byte{
-	// 997 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x13, 0xe7, 0xd7, 0x24, 0x5b, 0x60, 0xe8, 0x52, 0x27, 0xaa, 0xe2, 0xc8, 0x42, 0x55,
-	0xb4, 0x52, 0x6c, 0xb5, 0x8b, 0x38, 0xf4, 0x44, 0xb3, 0xed, 0x56, 0x5d, 0xb5, 0x62, 0x65, 0xb2,
-	0x08, 0xc1, 0x21, 0x9a, 0x3a, 0x53, 0xc7, 0xda, 0xd8, 0x63, 0x79, 0xc6, 0x26, 0xe1, 0xb8, 0x27,
-	0x4e, 0x80, 0xc4, 0x3f, 0xc0, 0x99, 0x13, 0x12, 0x7b, 0xe5, 0xbe, 0xe2, 0xb4, 0xb0, 0x17, 0xc4,
-	0x21, 0xa0, 0x14, 0x09, 0xa9, 0x07, 0x0e, 0xfc, 0x05, 0x68, 0x66, 0x9c, 0xa6, 0x49, 0xd5, 0x0a,
-	0x96, 0x4a, 0x7b, 0xca, 0x3c, 0x7f, 0x6f, 0xbe, 0x79, 0xf3, 0xbe, 0x6f, 0x66, 0x02, 0xde, 0x42,
-	0x11, 0xc3, 0x43, 0x64, 0xe1, 0xc4, 0xb7, 0x92, 0x4d, 0x8b, 0x8d, 0xcc, 0x30, 0x22, 0x8c, 0xc0,
-	0x5b, 0xf2, 0xbb, 0x89, 0x13, 0xdf, 0x4c, 0x36, 0xeb, 0x6b, 0x0e, 0xa1, 0x3e, 0xa1, 0x96, 0x4f,
-	0x5d, 0x9e, 0xe6, 0x53, 0x57, 0xe6, 0xd5, 0x6b, 0x12, 0xe8, 0x89, 0xc8, 0x92, 0x41, 0x0a, 0xad,
-	0x2d, 0x52, 0x73, 0x26, 0x09, 0xac, 0xba, 0xc4, 0x25, 0x72, 0x02, 0x1f, 0xa5, 0x5f, 0xd7, 0x5d,
-	0x42, 0xdc, 0x21, 0xb6, 0x50, 0xe8, 0x59, 0x28, 0x08, 0x08, 0x43, 0xcc, 0x23, 0xc1, 0x8c, 0xac,
-	0x96, 0xa2, 0x22, 0x3a, 0x8e, 0x4f, 0x2c, 0x14, 0x8c, 0x25, 0x64, 0x7c, 0xa9, 0x80, 0x5b, 0x47,
-	0xd4, 0xdd, 0x63, 0x03, 0x1c, 0xe1, 0xd8, 0xef, 0x8e, 0x60, 0x0b, 0xa8, 0x7d, 0xc4, 0x90, 0xa6,
-	0x34, 0x95, 0x56, 0x65, 0x6b, 0xd5, 0x94, 0x73, 0xcd, 0xd9, 0x5c, 0x73, 0x27, 0x18, 0xdb, 0x22,
-	0x03, 0xd6, 0x80, 0x4a, 0xbd, 0xcf, 0xb0, 0x96, 0x6d, 0x2a, 0x2d, 0xa5, 0x93, 0x3f, 0x9b, 0xe8,
-	0x4a, 0xdb, 0x16, 0x9f, 0xa0, 0x0e, 0xd4, 0x01, 0xa2, 0x03, 0x2d, 0xd7, 0x54, 0x5a, 0xe5, 0x4e,
-	0xe5, 0xef, 0x89, 0x5e, 0x8c, 0x86, 0xe1, 0xb6, 0xd1, 0x36, 0x6c, 0x01, 0x40, 0x08, 0xd4, 0x93,
-	0x88, 0xf8, 0x9a, 0xca, 0x13, 0x6c, 0x31, 0xde, 0x56, 0x3f, 0xff, 0x46, 0xcf, 0x18, 0xdf, 0x67,
-	0x41, 0xe9, 0x10, 0xbb, 0xc8, 0x19, 0x77, 0x47, 0x70, 0x15, 0xe4, 0x03, 0x12, 0x38, 0x58, 0x54,
-	0xa3, 0xda, 0x32, 0x80, 0xfb, 0xa0, 0xec, 0x22, 0xde, 0x36, 0xcf, 0x91, 0xab, 0x97, 0x3b, 0x77,
-	0x7e, 0x9d, 0xe8, 0x1b, 0xae, 0xc7, 0x06, 0xf1, 0xb1, 0xe9, 0x10, 0x3f, 0x6d, 0x66, 0xfa, 0xd3,
-	0xa6, 0xfd, 0xc7, 0x16, 0x1b, 0x87, 0x98, 0x9a, 0x07, 0x01, 0xb3, 0x4b, 0x2e, 0xa2, 0x0f, 0xf9,
-	0x5c, 0xd8, 0x00, 0x39, 0x17, 0x51, 0x51, 0xa5, 0xda, 0xa9, 0x4e, 0x27, 0x7a, 0x69, 0x1f, 0xd1,
-	0x43, 0xcf, 0xf7, 0x98, 0xcd, 0x01, 0xb8, 0x02, 0xb2, 0x8c, 0xa4, 0x35, 0x66, 0x19, 0x81, 0x0f,
-	0x40, 0x3e, 0x41, 0xc3, 0x18, 0x6b, 0x79, 0xb1, 0xe8, 0x3b, 0xff, 0x7e, 0xd1, 0xe9, 0x44, 0x2f,
-	0xec, 0xf8, 0x24, 0x0e, 0x98, 0x2d, 0x29, 0x78, 0x07, 0x44, 0x9f, 0x0b, 0x4d, 0xa5, 0x55, 0x4d,
-	0x3b, 0x5a, 0x05, 0x4a, 0xa2, 0x15, 0xc5, 0x07, 0x25, 0xe1, 0x51, 0xa4, 0x95, 0x64, 0x14, 0xf1,
-	0x88, 0x6a, 0x65, 0x19, 0xd1, 0xed, 0x15, 0xde, 0xab, 0x1f, 0x9f, 0xb6, 0x0b, 0xdd, 0xd1, 0x2e,
-	0x62, 0xc8, 0xf8, 0x2b, 0x07, 0xaa, 0x3b, 0x8e, 0x83, 0x29, 0x3d, 0xf4, 0x28, 0xeb, 0x8e, 0xe0,
-	0x27, 0xa0, 0xe4, 0x0c, 0x90, 0x17, 0xf4, 0xbc, 0xbe, 0x68, 0x5e, 0xb9, 0xf3, 0xde, 0x7f, 0xaa,
-	0xb6, 0x78, 0x8f, 0xcf, 0x3e, 0xd8, 0x3d, 0x9b, 0xe8, 0x45, 0x47, 0x0e, 0xed, 0x74, 0xd0, 0x9f,
-	0xcb, 0x92, 0xbd, 0x52, 0x96, 0xdc, 0xff, 0x97, 0x45, 0xbd, 0x5e, 0x96, 0xfc, 0x65, 0x59, 0x0a,
-	0x37, 0x27, 0x4b, 0xf1, 0x82, 0x2c, 0x1f, 0x81, 0x12, 0x12, 0xbd, 0xc5, 0x54, 0x2b, 0x35, 0x73,
-	0xad, 0xca, 0x56, 0xdd, 0x5c, 0x38, 0xe2, 0xa6, 0x6c, 0x7d, 0x37, 0x0e, 0x87, 0xb8, 0xd3, 0x7c,
-	0x36, 0xd1, 0x33, 0x67, 0x13, 0x1d, 0xa0, 0x73, 0x3d, 0xbe, 0xfd, 0x4d, 0x07, 0x73, 0x75, 0xec,
-	0x73, 0x36, 0x29, 0x78, 0x79, 0x41, 0x70, 0xb0, 0x20, 0x78, 0xe5, 0x2a, 0xc1, 0x7f, 0x50, 0x41,
-	0x75, 0x77, 0x1c, 0x20, 0xdf, 0x73, 0xee, 0x63, 0xfc, 0x6a, 0x04, 0x7f, 0x00, 0x2a, 0x5c, 0x70,
-	0xe6, 0x85, 0x3d, 0x07, 0x85, 0x2f, 0x21, 0x39, 0xf7, 0x4b, 0xd7, 0x0b, 0xef, 0xa1, 0x70, 0xc6,
-	0x75, 0x82, 0xb1, 0xe0, 0x52, 0x5f, 0x8a, 0xeb, 0x3e, 0xc6, 0x9c, 0x2b, 0xf5, 0x4f, 0xfe, 0x7a,
-	0xff, 0x14, 0x2e, 0xfb, 0xa7, 0x78, 0x73, 0xfe, 0x29, 0x5d, 0xe1, 0x9f, 0xf2, 0xcd, 0xfb, 0x07,
-	0x2c, 0xf8, 0xa7, 0xb2, 0xe0, 0x9f, 0xea, 0x55, 0xfe, 0x31, 0x40, 0x7d, 0x6f, 0xc4, 0x70, 0x40,
-	0x3d, 0x12, 0xbc, 0x1f, 0x8a, 0xd7, 0x62, 0xfe, 0x08, 0xa4, 0x57, 0xf1, 0x4f, 0x0a, 0xb8, 0xbd,
-	0xf0, 0x38, 0xd8, 0x98, 0x86, 0x24, 0xa0, 0x62, 0x97, 0xe2, 0x7e, 0x57, 0xe4, 0xf5, 0x2d, 0xae,
-	0xf4, 0x0d, 0xa0, 0x0e, 0x89, 0x4b, 0xb5, 0xac, 0xd8, 0x21, 0x5c, 0xda, 0xe1, 0x21, 0x71, 0x6d,
-	0x81, 0xc3, 0xd7, 0x41, 0x2e, 0xc2, 0x4c, 0xb8, 0xa5, 0x6a, 0xf3, 0x21, 0xac, 0x81, 0x52, 0xe2,
-	0xf7, 0x70, 0x14, 0x91, 0x28, 0xbd, 0x6c, 0x8b, 0x89, 0xbf, 0xc7, 0x43, 0x0e, 0x71, 0x5b, 0xc4,
-	0x14, 0xf7, 0xa5, 0x9e, 0x76, 0xd1, 0x45, 0xf4, 0x11, 0xc5, 0x7d, 0x68, 0x82, 0x37, 0x9d, 0xd8,
-	0x8f, 0x87, 0x88, 0x79, 0x09, 0xee, 0x9d, 0x67, 0x15, 0x44, 0xd6, 0x1b, 0x73, 0x68, 0x5f, 0xe6,
-	0xa7, 0x7b, 0xfa, 0x42, 0x01, 0xaf, 0x1d, 0x51, 0xf7, 0x51, 0xd8, 0x47, 0x0c, 0x3f, 0x44, 0x11,
-	0xf2, 0x29, 0x7c, 0x17, 0x94, 0x51, 0xcc, 0x06, 0x24, 0xf2, 0xd8, 0x38, 0x3d, 0x3b, 0xda, 0xcf,
-	0x4f, 0xdb, 0xab, 0xe9, 0x8b, 0xbc, 0xd3, 0xef, 0x47, 0x98, 0xd2, 0x0f, 0x58, 0xe4, 0x05, 0xae,
-	0x3d, 0x4f, 0x85, 0x77, 0x41, 0x21, 0x14, 0x0c, 0xe2, 0x58, 0x54, 0xb6, 0x6e, 0x2f, 0xed, 0x59,
-	0xd2, 0x77, 0x54, 0x2e, 0xa8, 0x9d, 0xa6, 0x6e, 0xaf, 0x3c, 0xf9, 0xf3, 0xbb, 0x3b, 0x73, 0x12,
-	0xa3, 0x06, 0xd6, 0x96, 0xea, 0x99, 0x75, 0x79, 0xeb, 0x85, 0x02, 0x72, 0x47, 0xd4, 0x85, 0x0c,
-	0x80, 0x0b, 0x0f, 0xf4, 0xfa, 0xd2, 0x2a, 0x0b, 0x0a, 0xd5, 0xdf, 0xbe, 0x0e, 0x9d, 0x31, 0x1b,
-	0xc6, 0x93, 0x17, 0x7f, 0x7c, 0x9d, 0x5d, 0x37, 0xea, 0xd6, 0xd2, 0xff, 0x8c, 0x34, 0xb5, 0xc7,
-	0x46, 0xf0, 0x43, 0x50, 0x5d, 0xe8, 0x52, 0xe3, 0x32, 0xf3, 0x45, 0xbc, 0xbe, 0x71, 0x3d, 0x3e,
-	0x5b, 0xbb, 0x73, 0xf0, 0x6c, 0xda, 0x50, 0x9e, 0x4f, 0x1b, 0xca, 0xef, 0xd3, 0x86, 0xf2, 0xd5,
-	0x69, 0x23, 0xf3, 0xfc, 0xb4, 0x91, 0xf9, 0xe5, 0xb4, 0x91, 0xf9, 0xd8, 0xba, 0x70, 0xe8, 0x24,
-	0x57, 0x3b, 0xc0, 0xec, 0x53, 0x12, 0x3d, 0x9e, 0x95, 0x99, 0x6c, 0x5a, 0x23, 0x51, 0xab, 0x38,
-	0x81, 0xc7, 0x05, 0xf1, 0xaf, 0xe4, 0xee, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9b, 0xec, 0xd3,
-	0xde, 0x89, 0x09, 0x00, 0x00,
+	// 996 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xc5, 0x56, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0x77, 0xbd, 0xbf, 0x66, 0xb7, 0x01, 0x4c, 0x4a, 0x9c, 0x55, 0x94, 0x2d, 0x56, 0x15,
+	0x55, 0x95, 0xd6, 0x56, 0xd3, 0x8a, 0x43, 0x4e, 0x64, 0x9b, 0x10, 0xa5, 0x0a, 0xa2, 0x32, 0x29,
+	0xaa, 0xca, 0x61, 0x35, 0xf1, 0x4e, 0xbc, 0x56, 0xd7, 0x1e, 0xcb, 0x33, 0xde, 0xee, 0x72, 0xec,
+	0x89, 0x13, 0x20, 0xf1, 0x0f, 0x70, 0xe6, 0x84, 0x44, 0xaf, 0xdc, 0x2b, 0x4e, 0x15, 0xbd, 0x20,
+	0x0e, 0x0b, 0x2a, 0x48, 0x48, 0x1c, 0x38, 0x20, 0xfe, 0x00, 0xde, 0xcc, 0x78, 0xb3, 0xf1, 0x46,
+	0x89, 0x4a, 0x89, 0xc4, 0x61, 0xe4, 0x79, 0xf3, 0xbe, 0xf9, 0xe6, 0xcd, 0xfb, 0xde, 0xcc, 0x18,
+	0xbd, 0x85, 0x13, 0x4e, 0x06, 0xd8, 0x21, 0xc3, 0xd0, 0x19, 0xde, 0x70, 0xf8, 0xc8, 0x8e, 0x13,
+	0xca, 0xa9, 0x71, 0x49, 0x8d, 0xdb, 0x30, 0x6e, 0x0f, 0x6f, 0x34, 0x97, 0x3d, 0xca, 0x42, 0xca,
+	0x9c, 0x90, 0xf9, 0x02, 0x06, 0x1f, 0x85, 0x6b, 0xae, 0x28, 0x47, 0x57, 0x5a, 0x8e, 0x32, 0x32,
+	0xd7, 0x72, 0x9e, 0x5a, 0x30, 0x29, 0xc7, 0x92, 0x4f, 0x7d, 0xaa, 0x26, 0x88, 0x5e, 0x36, 0xba,
+	0xea, 0x53, 0xea, 0x0f, 0x88, 0x83, 0xe3, 0xc0, 0xc1, 0x51, 0x44, 0x39, 0xe6, 0x01, 0x8d, 0xa6,
+	0x64, 0x2b, 0x99, 0x57, 0x5a, 0x87, 0xe9, 0x11, 0x40, 0xc6, 0xca, 0x65, 0x7d, 0xae, 0xa1, 0x4b,
+	0xef, 0x33, 0x7f, 0x87, 0xf7, 0x49, 0x42, 0xd2, 0xf0, 0x60, 0x64, 0x5c, 0x43, 0x7a, 0x0f, 0x73,
+	0x6c, 0x6a, 0x57, 0xb4, 0x6b, 0xf5, 0x8d, 0x25, 0x5b, 0xcd, 0xb5, 0xa7, 0x73, 0xed, 0xad, 0x68,
+	0xec, 0x4a, 0x84, 0xb1, 0x82, 0x74, 0x16, 0x7c, 0x42, 0xcc, 0x02, 0x20, 0xb5, 0x4e, 0xe9, 0x8f,
+	0x49, 0x4b, 0x6b, 0xbb, 0x72, 0xc8, 0x68, 0x21, 0xbd, 0x8f, 0x59, 0xdf, 0x2c, 0x82, 0xab, 0xd6,
+	0xa9, 0xff, 0x35, 0x69, 0x55, 0x92, 0x41, 0xbc, 0x69, 0xb5, 0x2d, 0x57, 0x3a, 0x0c, 0x03, 0xe9,
+	0x47, 0x09, 0x0d, 0x4d, 0x5d, 0x00, 0x5c, 0xd9, 0xdf, 0xd4, 0x3f, 0xfd, 0xaa, 0xb5, 0x60, 0x7d,
+	0x5b, 0x40, 0xd5, 0x7d, 0xe2, 0x63, 0x6f, 0x0c, 0xc1, 0x2c, 0xa1, 0x52, 0x44, 0x23, 0x8f, 0xc8,
+	0x68, 0x74, 0x57, 0x19, 0xc6, 0x2e, 0xaa, 0xf9, 0x58, 0xa4, 0x2d, 0xf0, 0xd4, 0xea, 0xb5, 0xce,
+	0xf5, 0x9f, 0x26, 0xad, 0x75, 0x3f, 0xe0, 0xfd, 0xf4, 0xd0, 0xf6, 0x68, 0x98, 0x25, 0x33, 0xfb,
+	0xb4, 0x59, 0xef, 0xa1, 0xc3, 0xc7, 0x31, 0x61, 0xf6, 0x5e, 0xc4, 0xdd, 0x2a, 0x4c, 0xbe, 0x2b,
+	0xe6, 0x1a, 0x6b, 0xa8, 0x08, 0x7d, 0x19, 0xa5, 0xde, 0x69, 0xbc, 0x98, 0xb4, 0xaa, 0xbb, 0x98,
+	0xed, 0x07, 0x61, 0xc0, 0x5d, 0xe1, 0x30, 0x16, 0x51, 0x81, 0xd3, 0x2c, 0x46, 0xe8, 0x19, 0x77,
+	0x50, 0x69, 0x88, 0x07, 0x29, 0x31, 0x4b, 0x72, 0xd1, 0x5b, 0x2f, 0xbf, 0x28, 0x70, 0x97, 0xb7,
+	0x42, 0x9a, 0xc2, 0xf2, 0x8a, 0x42, 0x64, 0x40, 0xe6, 0xb9, 0x0c, 0x54, 0x8d, 0x2c, 0xa3, 0x0d,
+	0xa4, 0x0d, 0xcd, 0x8a, 0x1c, 0xd0, 0x86, 0xc2, 0x4a, 0xcc, 0xaa, 0xb2, 0x12, 0x61, 0x31, 0xb3,
+	0xa6, 0x2c, 0xb6, 0xb9, 0x28, 0x72, 0xf5, 0xfd, 0x93, 0x76, 0xf9, 0x60, 0xb4, 0x0d, 0x33, 0xad,
+	0x3f, 0x8b, 0xa8, 0xb1, 0xe5, 0x79, 0x84, 0x41, 0xf8, 0x8c, 0x43, 0xe6, 0x3e, 0x46, 0x55, 0xaf,
+	0x8f, 0x83, 0xa8, 0x1b, 0xf4, 0x64, 0xf2, 0x6a, 0x9d, 0x77, 0xff, 0x55, 0xb4, 0x95, 0xdb, 0x62,
+	0xf6, 0xde, 0x36, 0xa8, 0x5a, 0xf1, 0x54, 0xd7, 0xcd, 0x3a, 0xbd, 0x99, 0x2c, 0x85, 0x33, 0x65,
+	0x29, 0xfe, 0x77, 0x59, 0xf4, 0xf3, 0x65, 0x29, 0x9d, 0x96, 0xa5, 0x7c, 0x71, 0xb2, 0x54, 0x4e,
+	0xc8, 0x72, 0x1f, 0x55, 0xb1, 0xcc, 0x2d, 0x61, 0xa0, 0x47, 0x11, 0x8e, 0x45, 0xd3, 0xce, 0x1d,
+	0x71, 0x5b, 0xa5, 0xfe, 0x20, 0x8d, 0x07, 0xa4, 0x73, 0xe5, 0xe9, 0xa4, 0xb5, 0x00, 0x69, 0x43,
+	0xf8, 0x58, 0x8f, 0xaf, 0x7f, 0x6e, 0xa1, 0x99, 0x3a, 0xee, 0x31, 0x9b, 0x12, 0xbc, 0x96, 0x13,
+	0x1c, 0xe5, 0x04, 0xaf, 0x9f, 0x25, 0xf8, 0x77, 0x3a, 0x6a, 0x6c, 0x8f, 0x23, 0x1c, 0x06, 0xde,
+	0x7b, 0x84, 0xfc, 0x3f, 0x82, 0xdf, 0x41, 0x75, 0x21, 0x38, 0x0f, 0xe2, 0xae, 0x87, 0xe3, 0x57,
+	0x90, 0x5c, 0xd4, 0xcb, 0x41, 0x10, 0xdf, 0xc6, 0xf1, 0x94, 0xeb, 0x88, 0x10, 0xc9, 0xa5, 0xbf,
+	0x12, 0x17, 0x64, 0x42, 0x70, 0x65, 0xf5, 0x53, 0x3a, 0xbf, 0x7e, 0xca, 0xa7, 0xeb, 0xa7, 0x72,
+	0x71, 0xf5, 0x53, 0x3d, 0xa3, 0x7e, 0x6a, 0x17, 0x5f, 0x3f, 0x28, 0x57, 0x3f, 0xf5, 0x5c, 0xfd,
+	0x34, 0xce, 0xaa, 0x1f, 0x0b, 0x35, 0x77, 0x46, 0x9c, 0x44, 0x0c, 0xde, 0x89, 0x0f, 0x62, 0xf9,
+	0x5a, 0xcc, 0x1e, 0x81, 0xec, 0x2a, 0xfe, 0x5b, 0x43, 0x97, 0x73, 0x8f, 0x83, 0x4b, 0x58, 0x0c,
+	0x40, 0xb9, 0x4b, 0x79, 0xbf, 0x6b, 0xea, 0xfa, 0x96, 0x57, 0xfa, 0x3a, 0xd2, 0x07, 0xd4, 0x67,
+	0x50, 0x22, 0x62, 0x87, 0xc6, 0xdc, 0x0e, 0xf7, 0xa9, 0xef, 0x4a, 0xbf, 0xf1, 0x3a, 0x2a, 0x26,
+	0x84, 0xcb, 0x6a, 0x69, 0xb8, 0xa2, 0x0b, 0x0f, 0x49, 0x75, 0x18, 0x76, 0x49, 0x92, 0xd0, 0x24,
+	0xbb, 0x6c, 0x2b, 0xc3, 0x70, 0x47, 0x98, 0xc2, 0x25, 0xca, 0x22, 0x65, 0xa4, 0xa7, 0xf4, 0x74,
+	0x2b, 0x60, 0xdf, 0x03, 0xd3, 0xb0, 0xd1, 0x9b, 0x5e, 0x1a, 0xa6, 0x03, 0x78, 0xea, 0x86, 0xa4,
+	0x7b, 0x8c, 0x2a, 0x4b, 0xd4, 0x1b, 0x33, 0xd7, 0x6e, 0x86, 0x7f, 0x1b, 0x35, 0x04, 0x28, 0x21,
+	0x47, 0x69, 0xd4, 0x03, 0x60, 0x45, 0x02, 0x45, 0xd5, 0xb9, 0xd9, 0x50, 0xb6, 0xed, 0xcf, 0x34,
+	0xf4, 0x1a, 0x6c, 0xfb, 0x5e, 0x0c, 0xe2, 0x91, 0xbb, 0x38, 0xc1, 0x21, 0x33, 0xde, 0x41, 0x35,
+	0x9c, 0xf2, 0x3e, 0x4d, 0x02, 0x3e, 0xce, 0x8e, 0x97, 0xf9, 0xc3, 0x93, 0xf6, 0x52, 0xf6, 0x68,
+	0x6f, 0xf5, 0x7a, 0x09, 0x28, 0xf2, 0x21, 0x4f, 0x82, 0xc8, 0x77, 0x67, 0x50, 0xe3, 0x26, 0x2a,
+	0xc7, 0x92, 0x41, 0x9e, 0x9c, 0xfa, 0xc6, 0xe5, 0xb9, 0xb4, 0x28, 0xfa, 0x8e, 0x2e, 0x34, 0x77,
+	0x33, 0xe8, 0xe6, 0xe2, 0xe3, 0xdf, 0xbf, 0xb9, 0x3e, 0x23, 0xb1, 0x56, 0xd0, 0xf2, 0x5c, 0x3c,
+	0x53, 0x21, 0x36, 0x9e, 0x6b, 0xa8, 0x08, 0x3e, 0x83, 0x23, 0x74, 0xe2, 0x0d, 0x5f, 0x9d, 0x5b,
+	0x25, 0x27, 0x62, 0xf3, 0xea, 0x79, 0xde, 0x29, 0xb3, 0x65, 0x3d, 0x7e, 0xfe, 0xdb, 0x97, 0x85,
+	0x55, 0xab, 0xe9, 0xcc, 0xfd, 0x8a, 0x64, 0xd0, 0x2e, 0x1f, 0x19, 0x1f, 0xa1, 0x46, 0x2e, 0x4b,
+	0x6b, 0xa7, 0x99, 0x4f, 0xfa, 0x9b, 0xeb, 0xe7, 0xfb, 0xa7, 0x6b, 0x77, 0xf6, 0x9e, 0xbe, 0x58,
+	0xd3, 0x9e, 0x41, 0xfb, 0x05, 0xda, 0x17, 0xbf, 0xae, 0x2d, 0x3c, 0x83, 0xf6, 0x23, 0xb4, 0x07,
+	0xce, 0x89, 0x73, 0xa9, 0xb8, 0xda, 0x11, 0xe1, 0x8f, 0x68, 0xf2, 0x70, 0x1a, 0x26, 0x84, 0x38,
+	0x92, 0xb1, 0xca, 0x43, 0x7a, 0x58, 0x96, 0x3f, 0x2e, 0x37, 0xff, 0x01, 0xd7, 0x3e, 0x68, 0xa4,
+	0xac, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1083,6 +1086,11 @@ func (m *MsgEthereumTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasRefunded != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasRefunded))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.CumulativeGasUsed != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.CumulativeGasUsed))
 		i--
@@ -1421,6 +1429,9 @@ func (m *MsgEthereumTxResponse) Size() (n int) {
 	if m.CumulativeGasUsed != 0 {
 		n += 1 + sovTx(uint64(m.CumulativeGasUsed))
 	}
+	if m.GasRefunded != 0 {
+		n += 1 + sovTx(uint64(m.GasRefunded))
+	}
 	return n
 }
 
@@ -3024,6 +3035,25 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRefunded", wireType)
+			}
+			m.GasRefunded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasRefunded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14,6 +14,9 @@ import (
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core"
 	ethereum "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/artela-network/artela/x/evm/txs/support"
+	"github.com/artela-network/artela/x/evm/types"
 )
 
 var (
@@ -78,6 +81,31 @@ func (m *MsgEthereumTxResponse) Revert() []byte {
 	return common.CopyBytes(m.Ret)
 }
 
+// Err returns the typed error of the failed execution, see types.ExecutionError, or nil if the
+// execution succeeded. The revert data of a reverted execution can be extracted with errors.As.
+func (m *MsgEthereumTxResponse) Err() error {
+	return types.NewExecutionError(m.VmError, m.Ret)
+}
+
+// ToTxResult returns the TxResult of the execution, with the address of the created contract,
+// if any, and the bloom of its logs.
+func (m *MsgEthereumTxResponse) ToTxResult(contractAddress *common.Address) support.TxResult {
+	res := support.TxResult{
+		TxLogs:      support.NewTransactionLogs(common.HexToHash(m.Hash), m.Logs),
+		Ret:         m.Ret,
+		Reverted:    m.VmError == vm.ErrExecutionReverted.Error(),
+		GasUsed:     m.GasUsed,
+		GasRefunded: m.GasRefunded,
+	}
+	if contractAddress != nil {
+		res.ContractAddress = contractAddress.Hex()
+	}
+	if logs := support.LogsToEthereum(m.Logs); len(logs) > 0 {
+		res.Bloom = ethereum.LogsBloom(logs)
+	}
+	return res
+}
+
 // ===============================================================
 //          		      TransactionArgs
 // ===============================================================
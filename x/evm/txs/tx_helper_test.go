@@ -0,0 +1,46 @@
+package txs
+
+import (
+	"testing"
+
+	"github.com/artela-network/artela-evm/vm"
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/txs/support"
+)
+
+func TestMsgEthereumTxResponseToTxResult(t *testing.T) {
+	txHash := common.HexToHash("0x1")
+	log := &ethereum.Log{Address: common.HexToAddress("0xa"), Topics: []common.Hash{common.HexToHash("0x2")}}
+	rsp := &MsgEthereumTxResponse{
+		Hash:        txHash.Hex(),
+		Logs:        support.NewLogsFromEth([]*ethereum.Log{log}),
+		GasUsed:     38_400,
+		GasRefunded: 9_600,
+	}
+
+	contract := common.HexToAddress("0xc0de")
+	res := rsp.ToTxResult(&contract)
+	require.Equal(t, contract.Hex(), res.ContractAddress)
+	require.Equal(t, ethereum.LogsBloom([]*ethereum.Log{log}), res.Bloom)
+	require.Equal(t, txHash.String(), res.TxLogs.Hash)
+	require.False(t, res.Reverted)
+	require.Equal(t, uint64(38_400), res.GasUsed)
+	require.Equal(t, uint64(9_600), res.GasRefunded)
+
+	// the refund survives the encoding
+	bz, err := res.Marshal()
+	require.NoError(t, err)
+	var decoded support.TxResult
+	require.NoError(t, decoded.Unmarshal(bz))
+	require.Equal(t, res.GasRefunded, decoded.GasRefunded)
+	require.Equal(t, res.GasUsed, decoded.GasUsed)
+
+	rsp = &MsgEthereumTxResponse{VmError: vm.ErrExecutionReverted.Error(), Ret: []byte{0x1}}
+	res = rsp.ToTxResult(nil)
+	require.True(t, res.Reverted)
+	require.Empty(t, res.ContractAddress)
+	require.Nil(t, res.Bloom)
+}
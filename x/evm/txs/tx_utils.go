@@ -19,7 +19,10 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 const (
@@ -202,18 +205,42 @@ func EffectiveGasPrice(baseFee, feeCap, tipCap *big.Int) *big.Int {
 	return math.BigMin(new(big.Int).Add(tipCap, baseFee), feeCap)
 }
 
+// EffectiveGasTip compute the effective miner tip based on eip-1159 rules, the tip is capped
+// at the fee cap minus the base fee
+// `effectiveGasTip = min(tipCap, feeCap - baseFee)`
+func EffectiveGasTip(baseFee, feeCap, tipCap *big.Int) *big.Int {
+	if baseFee == nil {
+		return new(big.Int).Set(tipCap)
+	}
+	return math.BigMin(tipCap, new(big.Int).Sub(feeCap, baseFee))
+}
+
+// ValidateDynamicFee checks that the fee cap of a dynamic fee txs is not lower than its tip
+// cap nor than the block base fee, as go-ethereum does before accepting a txs.
+func ValidateDynamicFee(txData TxData, baseFee *big.Int) error {
+	if txData.TxType() != ethereum.DynamicFeeTxType {
+		return nil
+	}
+
+	feeCap, tipCap := txData.GetGasFeeCap(), txData.GetGasTipCap()
+	if feeCap.Cmp(tipCap) < 0 {
+		return errorsmod.Wrapf(core.ErrTipAboveFeeCap, "gas tip cap %s, gas fee cap %s", tipCap, feeCap)
+	}
+	if baseFee != nil && feeCap.Cmp(baseFee) < 0 {
+		return errorsmod.Wrapf(core.ErrFeeCapTooLow, "gas fee cap %s, base fee %s", feeCap, baseFee)
+	}
+	return nil
+}
+
 // GetTxPriority returns the priority of a given Ethereum txs. It relies of the
 // priority reduction global variable to calculate the txs priority given the txs
 // tip price:
 //
 //	tx_priority = tip_price / priority_reduction
 func GetTxPriority(txData TxData, baseFee *big.Int) (priority int64) {
-	// calculate priority based on effective gas price
-	tipPrice := txData.EffectiveGasPrice(baseFee)
+	// calculate priority based on the effective tip, capped at feeCap - baseFee.
 	// if london hard fork is not enabled, tipPrice is the gasPrice
-	if baseFee != nil {
-		tipPrice = new(big.Int).Sub(tipPrice, baseFee)
-	}
+	tipPrice := EffectiveGasTip(baseFee, txData.GetGasFeeCap(), txData.GetGasTipCap())
 
 	priority = math.MaxInt64
 	priorityBig := new(big.Int).Quo(tipPrice, DefaultPriorityReduction.BigInt())
@@ -260,6 +287,52 @@ func DeriveChainID(v *big.Int) *big.Int {
 	return v.Div(v, big.NewInt(2))
 }
 
+// VerifyReplayProtection returns an error if the txs is not EIP-155 replay protected while
+// unprotected txs are not allowed.
+func VerifyReplayProtection(tx *ethereum.Transaction, allowUnprotectedTxs bool) error {
+	if allowUnprotectedTxs || tx.Protected() {
+		return nil
+	}
+	return errorsmod.Wrap(
+		errortypes.ErrNotSupported,
+		"unprotected transactions not allowed, please EIP155 sign your transaction to protect it against replay-attacks",
+	)
+}
+
+// TxSigner returns the signer to recover the sender of the txs with, the homestead signer for
+// unprotected txs and the signer of the fork active at the given block otherwise.
+func TxSigner(tx *ethereum.Transaction, cfg *params.ChainConfig, blockNumber *big.Int, blockTime uint64) ethereum.Signer {
+	if !tx.Protected() {
+		return ethereum.HomesteadSigner{}
+	}
+	return ethereum.MakeSigner(cfg, blockNumber, blockTime)
+}
+
+// blobTxType is the EIP-4844 blob txs type, not yet supported by the ethereum txs types.
+const blobTxType = 0x03
+
+// DetectTxType returns the EIP-2718 type of the raw txs bytes, LegacyTxType if the bytes
+// start with an RLP list prefix. An error is returned for empty bytes and unknown types.
+//
+// Ref: https://eips.ethereum.org/EIPS/eip-2718
+func DetectTxType(raw []byte) (uint8, error) {
+	if len(raw) == 0 {
+		return 0, errorsmod.Wrap(errortypes.ErrTxDecode, "empty transaction bytes")
+	}
+
+	// legacy txs are RLP lists
+	if raw[0] >= 0xc0 {
+		return ethereum.LegacyTxType, nil
+	}
+
+	switch raw[0] {
+	case ethereum.AccessListTxType, ethereum.DynamicFeeTxType, blobTxType:
+		return raw[0], nil
+	default:
+		return 0, errorsmod.Wrapf(ethereum.ErrTxTypeNotSupported, "unknown transaction type byte %#x", raw[0])
+	}
+}
+
 func rawSignatureValues(vBz, rBz, sBz []byte) (v, r, s *big.Int) {
 	if len(vBz) > 0 {
 		v = new(big.Int).SetBytes(vBz)
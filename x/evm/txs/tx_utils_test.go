@@ -0,0 +1,140 @@
+package txs
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+func newDynamicFeeTx(tipCap, feeCap int64) *DynamicFeeTx {
+	gasTipCap, gasFeeCap := sdkmath.NewInt(tipCap), sdkmath.NewInt(feeCap)
+	return &DynamicFeeTx{GasTipCap: &gasTipCap, GasFeeCap: &gasFeeCap}
+}
+
+func TestValidateDynamicFee(t *testing.T) {
+	baseFee := big.NewInt(100)
+
+	testCases := []struct {
+		name    string
+		txData  TxData
+		baseFee *big.Int
+		expErr  error
+	}{
+		{"valid", newDynamicFeeTx(10, 200), baseFee, nil},
+		{"fee cap equal to tip cap and base fee", newDynamicFeeTx(100, 100), baseFee, nil},
+		{"valid without base fee", newDynamicFeeTx(10, 20), nil, nil},
+		{"fee cap lower than tip cap", newDynamicFeeTx(300, 200), baseFee, core.ErrTipAboveFeeCap},
+		{"fee cap lower than base fee", newDynamicFeeTx(10, 99), baseFee, core.ErrFeeCapTooLow},
+		{"legacy txs are not checked", &LegacyTx{}, baseFee, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateDynamicFee(tc.txData, tc.baseFee)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestEffectiveGasTip(t *testing.T) {
+	// the tip is capped at feeCap - baseFee
+	require.Equal(t, big.NewInt(10), EffectiveGasTip(big.NewInt(100), big.NewInt(200), big.NewInt(10)))
+	require.Equal(t, big.NewInt(50), EffectiveGasTip(big.NewInt(100), big.NewInt(150), big.NewInt(80)))
+	// the tip is the gas price before london
+	require.Equal(t, big.NewInt(80), EffectiveGasTip(nil, big.NewInt(80), big.NewInt(80)))
+
+	// the priority is based on the capped tip
+	priority := GetTxPriority(newDynamicFeeTx(80_000_000, 150_000_000), big.NewInt(100_000_000))
+	require.Equal(t, int64(50), priority)
+}
+
+func TestVerifyReplayProtection(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.HexToAddress("0x1")
+	chainID := big.NewInt(11820)
+
+	// homestead style signature, without chain id
+	unprotected, err := ethereum.SignTx(
+		ethereum.NewTransaction(0, to, big.NewInt(1), 21000, big.NewInt(1), nil),
+		ethereum.HomesteadSigner{}, key,
+	)
+	require.NoError(t, err)
+	require.False(t, unprotected.Protected())
+
+	protected, err := ethereum.SignTx(
+		ethereum.NewTransaction(0, to, big.NewInt(1), 21000, big.NewInt(1), nil),
+		ethereum.NewEIP155Signer(chainID), key,
+	)
+	require.NoError(t, err)
+
+	cfg := &params.ChainConfig{ChainID: chainID, HomesteadBlock: common.Big0, EIP155Block: common.Big0}
+
+	// unprotected txs are rejected when not allowed
+	err = VerifyReplayProtection(unprotected, false)
+	require.ErrorIs(t, err, errortypes.ErrNotSupported)
+	require.Contains(t, err.Error(), "unprotected transactions not allowed")
+
+	// and recovered with the homestead signer when allowed
+	require.NoError(t, VerifyReplayProtection(unprotected, true))
+	sender, err := TxSigner(unprotected, cfg, big.NewInt(1), 0).Sender(unprotected)
+	require.NoError(t, err)
+	require.Equal(t, from, sender)
+
+	// protected txs are accepted regardless of the flag
+	require.NoError(t, VerifyReplayProtection(protected, false))
+	require.NoError(t, VerifyReplayProtection(protected, true))
+	sender, err = TxSigner(protected, cfg, big.NewInt(1), 0).Sender(protected)
+	require.NoError(t, err)
+	require.Equal(t, from, sender)
+}
+
+func TestDetectTxType(t *testing.T) {
+	testCases := []struct {
+		name    string
+		raw     []byte
+		expType uint8
+		expErr  bool
+	}{
+		{"legacy short list", []byte{0xc0}, ethereum.LegacyTxType, false},
+		{"legacy long list", []byte{0xf8, 0x6c}, ethereum.LegacyTxType, false},
+		{"access list", []byte{0x01, 0xf8}, ethereum.AccessListTxType, false},
+		{"dynamic fee", []byte{0x02, 0xf8}, ethereum.DynamicFeeTxType, false},
+		{"blob", []byte{0x03, 0xf8}, 0x03, false},
+		{"empty", nil, 0, true},
+		{"unknown type", []byte{0x04, 0xf8}, 0, true},
+		{"zero type", []byte{0x00}, 0, true},
+		{"rlp string prefix", []byte{0xb8}, 0, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txType, err := DetectTxType(tc.raw)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expType, txType)
+		})
+	}
+
+	// the detected type matches the encoded txs
+	tx := ethereum.NewTx(&ethereum.DynamicFeeTx{ChainID: big.NewInt(1), GasTipCap: common.Big1, GasFeeCap: common.Big1})
+	bz, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	txType, err := DetectTxType(bz)
+	require.NoError(t, err)
+	require.Equal(t, tx.Type(), txType)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -25,6 +26,9 @@ const (
 
 	// RouterKey uses module name for routing
 	RouterKey = ModuleName
+
+	// DefaultBloomSectionSize is the number of blocks of a bloom bits section
+	DefaultBloomSectionSize = 4096
 )
 
 // prefix bytes for the EVM persistent store
@@ -32,6 +36,10 @@ const (
 	prefixCode = iota + 1
 	prefixStorage
 	prefixParams
+	prefixBlockBloom
+	prefixBloomBits
+	prefixBloomSections
+	prefixContractCodeHash
 )
 
 // prefix bytes for the EVM transient store
@@ -70,6 +78,12 @@ var (
 	KeyPrefixCode    = []byte{prefixCode}
 	KeyPrefixStorage = []byte{prefixStorage}
 	KeyPrefixParams  = []byte{prefixParams}
+
+	KeyPrefixBlockBloom    = []byte{prefixBlockBloom}
+	KeyPrefixBloomBits     = []byte{prefixBloomBits}
+	KeyPrefixBloomSections = []byte{prefixBloomSections}
+
+	KeyPrefixContractCodeHash = []byte{prefixContractCodeHash}
 )
 
 // Transient Store key prefixes
@@ -90,6 +104,30 @@ func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
 }
 
+// StorageKey defines the full key under which the value of a storage slot of an account is
+// stored: the storage prefix, followed by the 20 bytes address and the 32 bytes slot.
+func StorageKey(address common.Address, slot common.Hash) []byte {
+	return StateKey(address, slot.Bytes())
+}
+
+// ParseStorageKey splits a key built with StorageKey into the address and the slot.
+func ParseStorageKey(key []byte) (common.Address, common.Hash, error) {
+	if len(key) != len(KeyPrefixStorage)+common.AddressLength+common.HashLength {
+		return common.Address{}, common.Hash{}, errorsmod.Wrapf(ErrInvalidState, "invalid storage key length %d", len(key))
+	}
+	if key[0] != prefixStorage {
+		return common.Address{}, common.Hash{}, errorsmod.Wrapf(ErrInvalidState, "invalid storage key prefix %x", key[0])
+	}
+
+	key = key[len(KeyPrefixStorage):]
+	return common.BytesToAddress(key[:common.AddressLength]), common.BytesToHash(key[common.AddressLength:]), nil
+}
+
+// ContractCodeHashKey defines the key under which the code hash of a contract is indexed.
+func ContractCodeHashKey(address common.Address) []byte {
+	return append(KeyPrefixContractCodeHash, address.Bytes()...)
+}
+
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
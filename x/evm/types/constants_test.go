@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageKey(t *testing.T) {
+	addr := common.HexToAddress("0x756F45E3FA69347A9A973A725E3C98bC4db0b5a0")
+	slot := common.HexToHash("0x01")
+
+	key := StorageKey(addr, slot)
+	require.Len(t, key, 1+common.AddressLength+common.HashLength)
+	require.Equal(t, StateKey(addr, slot.Bytes()), key)
+
+	parsedAddr, parsedSlot, err := ParseStorageKey(key)
+	require.NoError(t, err)
+	require.Equal(t, addr, parsedAddr)
+	require.Equal(t, slot, parsedSlot)
+}
+
+func TestParseStorageKeyMalformed(t *testing.T) {
+	key := StorageKey(common.HexToAddress("0x1"), common.HexToHash("0x2"))
+
+	testCases := []struct {
+		name string
+		key  []byte
+	}{
+		{"empty", nil},
+		{"prefix only", KeyPrefixStorage},
+		{"address prefix", AddressStoragePrefix(common.HexToAddress("0x1"))},
+		{"too short", key[:len(key)-1]},
+		{"too long", append(append([]byte{}, key...), 0x00)},
+		{"wrong prefix", append(append([]byte{}, KeyPrefixCode...), key[1:]...)},
+	}
+	for _, tc := range testCases {
+		_, _, err := ParseStorageKey(tc.key)
+		require.ErrorIs(t, err, ErrInvalidState, tc.name)
+	}
+}
@@ -3,8 +3,10 @@ package types
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	"github.com/artela-network/artela-evm/vm"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -29,6 +31,7 @@ const (
 	codeErrInvalidGasLimit
 	codeErrCallContract
 	codeErrAspectNotFound
+	codeErrInvalidPrecompile
 )
 
 var (
@@ -80,8 +83,74 @@ var (
 	ErrCallContract = errorsmod.Register(ModuleName, codeErrCallContract, "call contract error")
 
 	ErrAspectNotFound = errorsmod.Register(ModuleName, codeErrAspectNotFound, "aspect not found error")
+
+	// ErrInvalidPrecompile returns an error if a custom precompiled contract can not be registered
+	ErrInvalidPrecompile = errorsmod.Register(ModuleName, codeErrInvalidPrecompile, "invalid precompiled contract")
+)
+
+// Errors of a failed EVM execution, matched by the ExecutionError built from the VM error
+// message of the execution with errors.Is.
+var (
+	// ErrExecutionReverted is matched when the execution is aborted by the REVERT opcode, the
+	// revert data is carried by the ExecutionError.
+	ErrExecutionReverted = vm.ErrExecutionReverted
+
+	// ErrOutOfGas is matched when the execution runs out of gas.
+	ErrOutOfGas = vm.ErrOutOfGas
+
+	// ErrInvalidOpcode is matched when the execution hits an undefined opcode.
+	ErrInvalidOpcode = errors.New("invalid opcode")
+
+	// ErrContractAddressCollision is matched when a contract is created at the address of an
+	// existing contract.
+	ErrContractAddressCollision = vm.ErrContractAddressCollision
 )
 
+// ExecutionError is the error of a failed EVM execution. Its message is the VM error
+// message, so it is backward compatible with the VmError of the execution response, and
+// it matches the corresponding ErrExecutionReverted, ErrOutOfGas, ErrInvalidOpcode or
+// ErrContractAddressCollision with errors.Is.
+type ExecutionError struct {
+	// Ret is the revert data of a reverted execution, nil otherwise
+	Ret []byte
+
+	msg  string
+	kind error
+}
+
+// NewExecutionError returns the ExecutionError of the given VM error message and return
+// data, or nil if the message is empty, i.e. the execution succeeded.
+func NewExecutionError(vmError string, ret []byte) error {
+	if vmError == "" {
+		return nil
+	}
+
+	execErr := &ExecutionError{msg: vmError}
+	switch {
+	case vmError == ErrExecutionReverted.Error():
+		execErr.kind = ErrExecutionReverted
+		execErr.Ret = common.CopyBytes(ret)
+	case vmError == ErrOutOfGas.Error():
+		execErr.kind = ErrOutOfGas
+	case vmError == ErrContractAddressCollision.Error():
+		execErr.kind = ErrContractAddressCollision
+	case strings.HasPrefix(vmError, ErrInvalidOpcode.Error()):
+		// the message of vm.ErrInvalidOpCode carries the opcode, e.g. "invalid opcode: 0xfe"
+		execErr.kind = ErrInvalidOpcode
+	}
+	return execErr
+}
+
+// Error implements the error interface, it returns the VM error message.
+func (e *ExecutionError) Error() string {
+	return e.msg
+}
+
+// Unwrap returns the matched execution error, if any.
+func (e *ExecutionError) Unwrap() error {
+	return e.kind
+}
+
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
 // with the return reason.
 func NewExecErrorWithReason(revertReason []byte) *RevertError {
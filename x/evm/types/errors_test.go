@@ -0,0 +1,59 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/artela-network/artela-evm/vm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionErrorReverted(t *testing.T) {
+	ret := []byte{0x08, 0xc3, 0x79, 0xa0}
+	err := NewExecutionError(vm.ErrExecutionReverted.Error(), ret)
+
+	require.ErrorIs(t, err, ErrExecutionReverted)
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	require.NotErrorIs(t, err, ErrOutOfGas)
+	require.EqualError(t, err, "execution reverted")
+
+	// the revert data can be extracted, even from a wrapped error
+	var execErr *ExecutionError
+	require.ErrorAs(t, fmt.Errorf("call failed: %w", err), &execErr)
+	require.Equal(t, ret, execErr.Ret)
+
+	// the revert data is copied
+	ret[0] = 0
+	require.Equal(t, byte(0x08), execErr.Ret[0])
+}
+
+func TestExecutionErrorKinds(t *testing.T) {
+	testCases := []struct {
+		vmError string
+		kind    error
+	}{
+		{vm.ErrOutOfGas.Error(), ErrOutOfGas},
+		{vm.ErrContractAddressCollision.Error(), ErrContractAddressCollision},
+		{(&vm.ErrInvalidOpCode{}).Error(), ErrInvalidOpcode},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.vmError, func(t *testing.T) {
+			err := NewExecutionError(tc.vmError, []byte{0x1})
+			require.ErrorIs(t, err, tc.kind)
+			require.NotErrorIs(t, err, ErrExecutionReverted)
+			require.EqualError(t, err, tc.vmError)
+
+			var execErr *ExecutionError
+			require.True(t, errors.As(err, &execErr))
+			require.Nil(t, execErr.Ret)
+		})
+	}
+
+	// unknown errors keep their message and match no kind
+	err := NewExecutionError("stack underflow (0 <=> 1)", nil)
+	require.EqualError(t, err, "stack underflow (0 <=> 1)")
+	require.Nil(t, errors.Unwrap(err))
+
+	require.NoError(t, NewExecutionError("", nil))
+}